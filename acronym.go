@@ -0,0 +1,94 @@
+package inflect
+
+import "strings"
+
+// common acronyms and initialisms, which can't be read as English words;
+// ones like "RAM" are left to AddAcronym
+var acronymRules = []string{
+	"API",
+	"ASCII",
+	"CPU",
+	"CSS",
+	"CSV",
+	"DNS",
+	"FAQ",
+	"GPU",
+	"GUID",
+	"HTML",
+	"HTTP",
+	"HTTPS",
+	"ID",
+	"IP",
+	"JSON",
+	"JWT",
+	"PDF",
+	"SDK",
+	"SKU",
+	"SQL",
+	"SSH",
+	"TCP",
+	"TLS",
+	"UDP",
+	"UI",
+	"URI",
+	"URL",
+	"UUID",
+	"VM",
+	"XML",
+}
+
+// appendAcronym appends the plural or singular form of word to dst if
+// word is a registered acronym, like "URL", or its plural, like "URLs".
+func (rs *ruleSet) appendAcronym(dst []byte, word string, plural bool) ([]byte, bool) {
+	if len(rs.acronyms) == 0 {
+		return dst, false
+	}
+	var buf [64]byte
+	token := appendLower(buf[:0], word)
+	if a, ok := rs.acronyms[string(token)]; ok && a == word {
+		dst = append(dst, word...)
+		if plural {
+			dst = append(dst, 's')
+		}
+		return dst, true
+	}
+	n := len(word)
+	if n < 2 || word[n-1] != 's' {
+		return dst, false
+	}
+	base := word[:n-1]
+	if a, ok := rs.acronyms[string(token[:len(token)-1])]; ok && a == base {
+		if plural {
+			return append(dst, word...), true
+		}
+		return append(dst, base...), true
+	}
+	return dst, false
+}
+
+// AddAcronym registers an acronym like "URL". Acronyms written exactly as
+// registered are pluralized with a lower case "s", e.g. "URLs", and
+// singularized back. Other spellings, like "url", follow the usual rules.
+func (in *Inflector) AddAcronym(word string) {
+	in.mustAddRule(AcronymRule, word, "")
+}
+
+// AddAcronym registers an acronym in the default rule set.
+func AddAcronym(word string) {
+	defaultInflector.AddAcronym(word)
+}
+
+// acronymForm returns the registered form of an acronym in any case, like
+// "url", or of its plural, like "urls"
+func (rs *ruleSet) acronymForm(word string) (string, bool) {
+	token := strings.ToLower(word)
+	if a, ok := rs.acronyms[token]; ok {
+		return a, true
+	}
+	if n := len(token); n > 1 && token[n-1] == 's' {
+		if a, ok := rs.acronyms[token[:n-1]]; ok {
+			return a + "s", true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,64 @@
+package inflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var acronymTests = [][]string{
+	{"URL", "URLs"},
+	{"ID", "IDs"},
+	{"API", "APIs"},
+	{"CPU", "CPUs"},
+	{"SKU", "SKUs"},
+	{"FAQ", "FAQs"},
+	{"url", "urls"},
+	{"Url", "Urls"},
+}
+
+func TestAcronyms(t *testing.T) {
+	for i, test := range acronymTests {
+		assert.Equal(t, test[1], ToPlural(test[0]), "s: %s, i: %d", test[0], i)
+		assert.Equal(t, test[0], ToSingular(test[1]), "s: %s, i: %d", test[1], i)
+		// inflecting twice doesn't change the word
+		assert.Equal(t, test[1], ToPlural(test[1]), "s: %s, i: %d", test[1], i)
+		assert.Equal(t, test[0], ToSingular(test[0]), "s: %s, i: %d", test[0], i)
+	}
+}
+
+func TestAddAcronym(t *testing.T) {
+	in := New()
+	assert.Equal(t, "ISBNS", in.ToPlural("ISBN"))
+	in.AddAcronym("ISBN")
+	assert.Equal(t, "ISBNs", in.ToPlural("ISBN"))
+	assert.Equal(t, "ISBN", in.ToSingular("ISBNs"))
+	assert.Equal(t, "BookISBNs", in.PluralizeIdentifier("BookISBN"))
+	assert.Equal(t, "BookISBN", in.SingularizeIdentifier("BookISBNs"))
+	assert.Equal(t, "ISBNS", ToPlural("ISBN"))
+
+	ex := in.Explain("ISBN")
+	assert.Equal(t, SourceAcronym, ex.Plural.Source)
+	assert.Equal(t, "acronym", ex.Plural.Source.String())
+
+	assert.Error(t, in.AddRule(AcronymRule, "", ""))
+}
+
+func TestAcronymsAreNotWords(t *testing.T) {
+	assert.Equal(t, "BatteringRams", Camelize("battering_rams"))
+	assert.Equal(t, "The Last Ram", Titleize("the_last_ram"))
+	in := New()
+	in.AddAcronym("RAM")
+	assert.Equal(t, "VideoRAM", in.Camelize("video_ram"))
+}
+
+func TestAppendAcronymAllocs(t *testing.T) {
+	in := New()
+	buf := make([]byte, 0, 64)
+	in.AppendPlural(buf, "URL")
+	n := testing.AllocsPerRun(100, func() {
+		buf = in.AppendPlural(buf[:0], "URL")
+	})
+	assert.Equal(t, "URLs", string(buf))
+	assert.Equal(t, 0.0, n)
+}
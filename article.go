@@ -0,0 +1,156 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+)
+
+// words starting with a silent "h" take "an"
+var anPrefixes = []string{
+	"heir",
+	"honest",
+	"honor",
+	"honour",
+	"hour",
+}
+
+// words starting with a vowel that sounds like a consonant take "a"
+var aPrefixes = []string{
+	"eu",
+	"ewe",
+	"once",
+	"ouija",
+	"ubiq",
+	"uku",
+	"unan",
+	"uni",
+	"ura",
+	"ure",
+	"uri",
+	"uro",
+	"usa",
+	"use",
+	"usu",
+	"uten",
+	"uter",
+	"uti",
+	"uto",
+	"uvu",
+}
+
+// exceptions to aPrefixes, e.g. "an uninformed"
+var anUniPrefixes = []string{
+	"unide",
+	"unim",
+	"unin",
+}
+
+// letters whose names start with a vowel sound, e.g. "an F"
+const anLetters = "aefhilmnorsx"
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// firstWord returns the first word of s, without leading punctuation
+func firstWord(s string) string {
+	s = strings.TrimLeftFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if i := strings.IndexFunc(s, isPhraseSeparator); i >= 0 {
+		s = s[:i]
+	}
+	return s
+}
+
+// numberArticle returns the article for a number written in digits, e.g.
+// "an 8", "an 11" and "an 18000" but "a 1" and "a 110"
+func numberArticle(word string) string {
+	var digits []byte
+	for i := 0; i < len(word); i++ {
+		c := word[i]
+		if c >= '0' && c <= '9' {
+			digits = append(digits, c)
+		} else if c != ',' && c != '_' {
+			break
+		}
+	}
+	if digits[0] == '8' {
+		return "an"
+	}
+	// "eleven", "eleven thousand"
+	if len(digits)%3 == 2 && digits[0] == '1' && (digits[1] == '1' || digits[1] == '8') {
+		return "an"
+	}
+	return "a"
+}
+
+// isInitialism returns true if word is read letter by letter, like "FBI"
+func (rs *ruleSet) isInitialism(word string) bool {
+	base := strings.TrimSuffix(word, "s")
+	if base == "" || !isUpper(base) || strings.IndexFunc(base, unicode.IsUpper) < 0 {
+		return false
+	}
+	if _, ok := rs.acronymForm(base); ok {
+		return true
+	}
+	// short words and words without vowels, like "HTML", but not "NASA"
+	return len(base) <= 3 || strings.IndexAny(base, "AEIOU") < 0
+}
+
+// Article returns the indefinite article, "a" or "an", for word based on
+// how it's pronounced, e.g. "an hour", "a unicorn", "a one-off", "an MRI"
+// and "an 8".
+func (in *Inflector) Article(word string) string {
+	word = firstWord(word)
+	if word == "" {
+		return "a"
+	}
+	if c := word[0]; c >= '0' && c <= '9' {
+		return numberArticle(word)
+	}
+	if in.load().isInitialism(word) {
+		if strings.ContainsRune(anLetters, unicode.ToLower(rune(word[0]))) {
+			return "an"
+		}
+		return "a"
+	}
+	w := strings.ToLower(word)
+	switch {
+	case w == "one" || w == "ones":
+		return "a"
+	case len(w) == 1:
+		// letter names, e.g. "an f"
+		if strings.Contains(anLetters, w) {
+			return "an"
+		}
+		return "a"
+	case hasAnyPrefix(w, anPrefixes), hasAnyPrefix(w, anUniPrefixes):
+		return "an"
+	case hasAnyPrefix(w, aPrefixes):
+		return "a"
+	case strings.IndexByte("aeiou", w[0]) >= 0:
+		return "an"
+	}
+	return "a"
+}
+
+// WithArticle returns word with its indefinite article, e.g. "an apple".
+func (in *Inflector) WithArticle(word string) string {
+	return in.Article(word) + " " + word
+}
+
+// Article returns the indefinite article, "a" or "an", for word.
+func Article(word string) string {
+	return defaultInflector.Article(word)
+}
+
+// WithArticle returns word with its indefinite article.
+func WithArticle(word string) string {
+	return defaultInflector.WithArticle(word)
+}
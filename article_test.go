@@ -0,0 +1,82 @@
+package inflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArticle(t *testing.T) {
+	tests := [][]string{
+		{"apple", "an"},
+		{"Apple", "an"},
+		{"banana", "a"},
+		{"item", "an"},
+		{"user", "a"},
+		{"umbrella", "an"},
+		{"hour", "an"},
+		{"hourly rate", "an"},
+		{"honest mistake", "an"},
+		{"heir", "an"},
+		{"hotel", "a"},
+		{"unicorn", "a"},
+		{"university", "a"},
+		{"uninformed guess", "an"},
+		{"unimportant detail", "an"},
+		{"unanimous vote", "a"},
+		{"utility", "a"},
+		{"usual suspect", "a"},
+		{"European", "a"},
+		{"ewe", "a"},
+		{"one-off", "a"},
+		{"one", "a"},
+		{"onerous task", "an"},
+		{"once-in-a-lifetime chance", "a"},
+		{"MRI", "an"},
+		{"FAQ", "an"},
+		{"FBI agent", "an"},
+		{"SKU", "an"},
+		{"HTML page", "an"},
+		{"URL", "a"},
+		{"UUID", "a"},
+		{"API", "an"},
+		{"NASA", "a"},
+		{"UNIX system", "a"},
+		{"X", "an"},
+		{"u", "a"},
+		{"f", "an"},
+		{"8", "an"},
+		{"8-year-old", "an"},
+		{"11", "an"},
+		{"18th", "an"},
+		{"11,000", "an"},
+		{"110", "a"},
+		{"1", "a"},
+		{"180", "a"},
+		{"\"apple\"", "an"},
+		{"", "a"},
+	}
+	for i, test := range tests {
+		assert.Equal(t, test[1], Article(test[0]), "s: %s, i: %d", test[0], i)
+	}
+	assert.Equal(t, "an apple", WithArticle("apple"))
+	assert.Equal(t, "a unicorn", WithArticle("unicorn"))
+}
+
+func TestArticleAcronyms(t *testing.T) {
+	in := New()
+	assert.Equal(t, "a", in.Article("SCUBA"))
+	assert.Equal(t, "an", in.Article("LSTM"))
+	assert.Equal(t, "a", in.Article("NATO"))
+	in.AddAcronym("NATO")
+	assert.Equal(t, "an", in.Article("NATO"))
+	assert.Equal(t, "a", Article("NATO"))
+}
+
+func TestPluralizeArticle(t *testing.T) {
+	opts := PluralizeOptions{Article: true}
+	assert.Equal(t, "an apple", PluralizeWith("apple", 1, opts))
+	assert.Equal(t, "a person", PluralizeWith("people", 1, opts))
+	assert.Equal(t, "2 apples", PluralizeWith("apple", 2, opts))
+	assert.Equal(t, "1 apple", Pluralize("apple", 1, true))
+}
@@ -0,0 +1,131 @@
+package inflect
+
+import (
+	"container/list"
+	"sync"
+)
+
+// CacheStats describes how effective the cache of an Inflector is.
+type CacheStats struct {
+	Hits     uint64
+	Misses   uint64
+	Size     int // number of cached words
+	Capacity int // maximum number of cached words
+}
+
+type cacheKey struct {
+	plural bool
+	word   string
+}
+
+type cacheEntry struct {
+	key   cacheKey
+	value string
+	// rules used to compute the value; if they changed, the entry is stale
+	rules *ruleSet
+}
+
+// lruCache is a size-bounded cache that evicts least recently used words.
+// It's safe for concurrent use.
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[cacheKey]*list.Element
+	hits     uint64
+	misses   uint64
+}
+
+func newLRUCache(capacity int) *lruCache {
+	return &lruCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    map[cacheKey]*list.Element{},
+	}
+}
+
+func (c *lruCache) get(rs *ruleSet, plural bool, word string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[cacheKey{plural, word}]; ok {
+		e := el.Value.(*cacheEntry)
+		if e.rules == rs {
+			c.ll.MoveToFront(el)
+			c.hits++
+			return e.value, true
+		}
+	}
+	c.misses++
+	return "", false
+}
+
+func (c *lruCache) add(rs *ruleSet, plural bool, word string, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := cacheKey{plural, word}
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		e := el.Value.(*cacheEntry)
+		e.value = value
+		e.rules = rs
+		return
+	}
+	c.items[key] = c.ll.PushFront(&cacheEntry{key: key, value: value, rules: rs})
+	if c.ll.Len() > c.capacity {
+		el := c.ll.Back()
+		c.ll.Remove(el)
+		delete(c.items, el.Value.(*cacheEntry).key)
+	}
+}
+
+// purge removes all entries but keeps the statistics
+func (c *lruCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ll.Init()
+	c.items = map[cacheKey]*list.Element{}
+}
+
+func (c *lruCache) stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CacheStats{
+		Hits:     c.hits,
+		Misses:   c.misses,
+		Size:     c.ll.Len(),
+		Capacity: c.capacity,
+	}
+}
+
+// EnableCache makes ToPlural and ToSingular remember results for up to
+// size most recently used words, so repeated lookups don't have to run
+// the rules. Size of 0 disables the cache. Enabling the cache again
+// discards cached words and resets statistics.
+//
+// Adding rules clears the cache.
+func (in *Inflector) EnableCache(size int) {
+	var c *lruCache
+	if size > 0 {
+		c = newLRUCache(size)
+	}
+	in.cache.Store(c)
+}
+
+// CacheStats returns statistics of the cache. They're all zero if
+// the cache is not enabled.
+func (in *Inflector) CacheStats() CacheStats {
+	if c := in.loadCache(); c != nil {
+		return c.stats()
+	}
+	return CacheStats{}
+}
+
+func (in *Inflector) loadCache() *lruCache {
+	c, _ := in.cache.Load().(*lruCache)
+	return c
+}
+
+// EnableCache enables the cache of the default Inflector.
+func EnableCache(size int) {
+	defaultInflector.EnableCache(size)
+}
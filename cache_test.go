@@ -0,0 +1,88 @@
+package inflect
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache(t *testing.T) {
+	in := New()
+	assert.Equal(t, CacheStats{}, in.CacheStats())
+
+	in.EnableCache(2)
+	assert.Equal(t, "users", in.ToPlural("user"))
+	assert.Equal(t, "users", in.ToPlural("user"))
+	assert.Equal(t, "user", in.ToSingular("users"))
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 2, Size: 2, Capacity: 2}, in.CacheStats())
+
+	// plural of "user" is the least recently used and gets evicted
+	assert.Equal(t, "accounts", in.ToPlural("account"))
+	assert.Equal(t, "user", in.ToSingular("users"))
+	assert.Equal(t, "users", in.ToPlural("user"))
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 4, Size: 2, Capacity: 2}, in.CacheStats())
+
+	// adding rules invalidates cached results
+	in.AddIrregularRule("user", "userz")
+	assert.Equal(t, 0, in.CacheStats().Size)
+	assert.Equal(t, "userz", in.ToPlural("user"))
+	assert.Equal(t, "userz", in.ToPlural("user"))
+	assert.Equal(t, CacheStats{Hits: 3, Misses: 5, Size: 1, Capacity: 2}, in.CacheStats())
+
+	in.EnableCache(0)
+	assert.Equal(t, "userz", in.ToPlural("user"))
+	assert.Equal(t, CacheStats{}, in.CacheStats())
+}
+
+func TestCacheStaleEntry(t *testing.T) {
+	c := newLRUCache(10)
+	rs1, rs2 := newRuleSet(), newRuleSet()
+	c.add(rs1, true, "user", "users")
+	s, ok := c.get(rs1, true, "user")
+	assert.True(t, ok)
+	assert.Equal(t, "users", s)
+	_, ok = c.get(rs2, true, "user")
+	assert.False(t, ok)
+	_, ok = c.get(rs1, false, "user")
+	assert.False(t, ok)
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	in := New()
+	in.EnableCache(16)
+	exp := map[string]string{}
+	for _, w := range benchWords {
+		exp[w] = ToPlural(w)
+	}
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				w := benchWords[i%len(benchWords)]
+				assert.Equal(t, exp[w], in.ToPlural(w))
+				if g == 0 && i%10 == 0 {
+					in.AddUncountableRule(fmt.Sprintf("thing%d", i))
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	st := in.CacheStats()
+	assert.Equal(t, uint64(400), st.Hits+st.Misses)
+	assert.True(t, st.Size <= 16)
+}
+
+func BenchmarkToPluralCached(b *testing.B) {
+	in := New()
+	in.EnableCache(1024)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, w := range benchWords {
+			in.ToPlural(w)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package inflect
+
+import "strings"
+
+// NumberStyle tells how numbers are written in words.
+type NumberStyle int
+
+const (
+	// American style doesn't use "and", e.g. "one hundred five".
+	American NumberStyle = iota
+	// British style puts "and" before the tens, e.g. "one hundred and five".
+	British
+)
+
+var smallNumberWords = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+	"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+	"seventeen", "eighteen", "nineteen",
+}
+
+var tensWords = []string{
+	"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+}
+
+// scale words for powers of 1000, up to the range of int64
+var scaleWords = []string{
+	"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+}
+
+// SpellOut tells PluralizeWith to write small counts in words, e.g.
+// "three cats" instead of "3 cats".
+type SpellOut struct {
+	// Counts whose magnitude is below Threshold are written in words,
+	// e.g. 10 spells out counts from -9 to 9. 0 disables spelling out.
+	Threshold int64
+	Style     NumberStyle
+}
+
+// abs returns the magnitude of n, which also works for math.MinInt64
+func abs(n int64) uint64 {
+	if n < 0 {
+		return -uint64(n)
+	}
+	return uint64(n)
+}
+
+// appendHundreds appends words for n < 1000 to b
+func appendHundreds(b *strings.Builder, n uint64, style NumberStyle) {
+	if n >= 100 {
+		b.WriteString(smallNumberWords[n/100])
+		b.WriteString(" hundred")
+		n %= 100
+		if n == 0 {
+			return
+		}
+		b.WriteByte(' ')
+		if style == British {
+			b.WriteString("and ")
+		}
+	}
+	if n < 20 {
+		b.WriteString(smallNumberWords[n])
+		return
+	}
+	b.WriteString(tensWords[n/10])
+	if n%10 != 0 {
+		b.WriteByte('-')
+		b.WriteString(smallNumberWords[n%10])
+	}
+}
+
+// CardinalWord returns n in English words, e.g. "one hundred twenty-three"
+// or "minus five" in American style, and "one hundred and twenty-three" in
+// British style.
+func CardinalWord(n int64, style NumberStyle) string {
+	u := abs(n)
+	if u == 0 {
+		return smallNumberWords[0]
+	}
+	var groups [7]uint64
+	ngroups := 0
+	for ; u > 0; u /= 1000 {
+		groups[ngroups] = u % 1000
+		ngroups++
+	}
+	var b strings.Builder
+	if n < 0 {
+		b.WriteString("minus")
+	}
+	for i := ngroups - 1; i >= 0; i-- {
+		g := groups[i]
+		if g == 0 {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+			// "one thousand and five"
+			if i == 0 && g < 100 && style == British && ngroups > 1 {
+				b.WriteString("and ")
+			}
+		}
+		appendHundreds(&b, g, style)
+		if i > 0 {
+			b.WriteByte(' ')
+			b.WriteString(scaleWords[i])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,69 @@
+package inflect
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCardinalWord(t *testing.T) {
+	tests := []struct {
+		n int64
+		s string
+	}{
+		{0, "zero"},
+		{7, "seven"},
+		{13, "thirteen"},
+		{20, "twenty"},
+		{42, "forty-two"},
+		{100, "one hundred"},
+		{105, "one hundred five"},
+		{999, "nine hundred ninety-nine"},
+		{1000, "one thousand"},
+		{1001, "one thousand one"},
+		{21000, "twenty-one thousand"},
+		{1000000, "one million"},
+		{1002003, "one million two thousand three"},
+		{-5, "minus five"},
+		{math.MaxInt64, "nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred seven"},
+		{math.MinInt64, "minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.s, CardinalWord(test.n, American), "n: %d", test.n)
+	}
+}
+
+func TestCardinalWordBritish(t *testing.T) {
+	tests := []struct {
+		n int64
+		s string
+	}{
+		{5, "five"},
+		{100, "one hundred"},
+		{105, "one hundred and five"},
+		{342, "three hundred and forty-two"},
+		{1005, "one thousand and five"},
+		{1100, "one thousand one hundred"},
+		{2340, "two thousand three hundred and forty"},
+		{1000001, "one million and one"},
+		{-1005, "minus one thousand and five"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.s, CardinalWord(test.n, British), "n: %d", test.n)
+	}
+}
+
+func TestSpellOut(t *testing.T) {
+	opts := PluralizeOptions{SpellOut: SpellOut{Threshold: 10}}
+	assert.Equal(t, "three cats", PluralizeWith("cat", 3, opts))
+	assert.Equal(t, "one cat", PluralizeWith("cat", 1, opts))
+	assert.Equal(t, "zero cats", PluralizeWith("cat", 0, opts))
+	assert.Equal(t, "minus two degrees", PluralizeWith("degree", -2, opts))
+	assert.Equal(t, "10 cats", PluralizeWith("cat", 10, opts))
+	assert.Equal(t, "3 cats", Pluralize("cat", 3, true))
+
+	opts.SpellOut = SpellOut{Threshold: 1000, Style: British}
+	assert.Equal(t, "one hundred and five items", PluralizeWith("item", 105, opts))
+	assert.Equal(t, "105 items", PluralizeWith("item", 105, PluralizeOptions{}))
+}
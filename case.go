@@ -0,0 +1,95 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// isUpper returns true if s has no lower case letters
+func isUpper(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= utf8.RuneSelf {
+			return strings.IndexFunc(s[i:], unicode.IsLower) < 0
+		}
+		if c >= 'a' && c <= 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isLower returns true if s has no upper case letters
+func isLower(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= utf8.RuneSelf {
+			return strings.IndexFunc(s[i:], unicode.IsUpper) < 0
+		}
+		if c >= 'A' && c <= 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// restoreCase applies the case of word to token, which is the lower case
+// form of its inflection:
+//   - "HELLO" is upper case, so is the result
+//   - "Title" is title case, so is the result
+//   - "test" is lower case, so is the result
+//   - mixed case words like "iPad" or "McDonald" have the case of each
+//     letter copied to the letter at the same position
+func restoreCase(word string, token string) string {
+	// Tokens are an exact match.
+	if word == token {
+		return token
+	}
+
+	// Upper cased words. E.g. "HELLO".
+	if isUpper(word) {
+		return strings.ToUpper(token)
+	}
+
+	// Lower cased words. E.g. "test".
+	if isLower(word) {
+		return strings.ToLower(token)
+	}
+
+	// Title cased words. E.g. "Title".
+	first, n := utf8.DecodeRuneInString(word)
+	if unicode.IsUpper(first) && isLower(word[n:]) {
+		_, n = utf8.DecodeRuneInString(token)
+		return strings.ToUpper(token[:n]) + strings.ToLower(token[n:])
+	}
+
+	// Mixed case words. E.g. "iPad".
+	return mapCase(word, token)
+}
+
+// mapCase copies the case of each letter in word to the letter at the same
+// position in token. Letters in token past the end of word have the case of
+// the last letter in word.
+func mapCase(word, token string) string {
+	var b strings.Builder
+	b.Grow(len(token))
+	upper := false
+	for _, r := range token {
+		if len(word) > 0 {
+			w, n := utf8.DecodeRuneInString(word)
+			word = word[n:]
+			if unicode.IsUpper(w) {
+				upper = true
+			} else if unicode.IsLower(w) {
+				upper = false
+			}
+		}
+		if upper {
+			b.WriteRune(unicode.ToUpper(r))
+		} else {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,57 @@
+package inflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsUpper(t *testing.T) {
+	assert.True(t, isUpper("HELLO"))
+	assert.True(t, isUpper("ÉCOLE"))
+	assert.True(t, isUpper("ÜBER_2"))
+	assert.False(t, isUpper("École"))
+	assert.False(t, isUpper("ÉCOLe"))
+	assert.False(t, isUpper("ÉCOLé"))
+	assert.False(t, isUpper("hello"))
+	assert.True(t, isLower("école"))
+	assert.False(t, isLower("écolÉ"))
+}
+
+var restoreCaseTests = [][]string{
+	{"person", "people", "people"},
+	{"Person", "people", "People"},
+	{"PERSON", "people", "PEOPLE"},
+	{"iPad", "ipads", "iPads"},
+	{"iPhone", "iphones", "iPhones"},
+	{"McDonald", "mcdonalds", "McDonalds"},
+	{"eBook", "ebooks", "eBooks"},
+	{"ÉCOLE", "écoles", "ÉCOLES"},
+	{"École", "écoles", "Écoles"},
+	{"Ökonom", "ökonomen", "Ökonomen"},
+	{"éCOLE", "écoles", "éCOLES"},
+	{"ÜbErMaN", "übermen", "ÜbErMeN"},
+	{"Ox", "oxen", "Oxen"},
+	{"oX", "oxen", "oXEN"},
+}
+
+func TestRestoreCase(t *testing.T) {
+	for i, test := range restoreCaseTests {
+		assert.Equal(t, test[2], restoreCase(test[0], test[1]), "s: %s, i: %d", test[0], i)
+	}
+}
+
+func TestInflectCase(t *testing.T) {
+	in := New()
+	in.AddIrregularRule("ipad", "ipodes")
+	in.AddIrregularRule("mcdonald", "mcdonalds")
+	assert.Equal(t, "iPodes", in.ToPlural("iPad"))
+	assert.Equal(t, "iPad", in.ToSingular("iPodes"))
+	assert.Equal(t, "McDonalds", in.ToPlural("McDonald"))
+	assert.Equal(t, "McDonald", in.ToSingular("McDonalds"))
+	assert.Equal(t, "iPhones", in.ToPlural("iPhone"))
+	assert.Equal(t, "ÉCOLES", in.ToPlural("ÉCOLE"))
+	assert.Equal(t, "Écoles", in.ToPlural("École"))
+	assert.Equal(t, "ÉCOLE", in.ToSingular("ÉCOLES"))
+	assert.Equal(t, "ÖXES", in.ToPlural("ÖX"))
+}
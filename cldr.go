@@ -0,0 +1,454 @@
+package inflect
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PluralForm is a CLDR plural category. Languages pick a form of a word
+// depending on the category of a number, e.g. in Polish it's "1 plik",
+// "2 pliki" and "5 plików" for forms One, Few and Many.
+type PluralForm int
+
+const (
+	// Other is used by all languages, e.g. for "2 files" in English.
+	Other PluralForm = iota
+	// Zero is used e.g. in Arabic, Latvian and Welsh.
+	Zero
+	// One is used e.g. for "1 file" in English.
+	One
+	// Two is used e.g. in Arabic, Irish, Slovenian and Welsh.
+	Two
+	// Few is used e.g. for 2 to 4 in Czech, Polish and Russian.
+	Few
+	// Many is used e.g. for 5 to 20 in Polish and Russian.
+	Many
+)
+
+var pluralFormNames = []string{"other", "zero", "one", "two", "few", "many"}
+
+func (f PluralForm) String() string {
+	if f >= 0 && int(f) < len(pluralFormNames) {
+		return pluralFormNames[f]
+	}
+	return "unknown"
+}
+
+// Operands are the CLDR plural operands of a number written in decimal
+// digits, e.g. "1.50" has N 1.5, I 1, V 2, W 1, F 50 and T 5. The
+// exponent operand e, used for compact numbers like "1.2M", is always 0.
+type Operands struct {
+	N float64 // absolute value
+	I uint64  // integer digits
+	V int     // number of visible fraction digits, with trailing zeros
+	W int     // number of visible fraction digits, without trailing zeros
+	F uint64  // visible fraction digits, with trailing zeros
+	T uint64  // visible fraction digits, without trailing zeros
+}
+
+// ParseOperands returns the plural operands of a number like "-12" or
+// "1.50". Trailing zeros matter, e.g. "1.0" is not in category One in
+// English.
+func ParseOperands(s string) (Operands, error) {
+	var op Operands
+	num := strings.TrimPrefix(s, "-")
+	intPart, frac := num, ""
+	if i := strings.IndexByte(num, '.'); i >= 0 {
+		intPart, frac = num[:i], num[i+1:]
+		if frac == "" {
+			return op, fmt.Errorf("inflect: invalid number %q", s)
+		}
+	}
+	if !isDigits(intPart) || !isDigits(frac) {
+		return op, fmt.Errorf("inflect: invalid number %q", s)
+	}
+	var err error
+	if op.I, err = strconv.ParseUint(intPart, 10, 64); err != nil {
+		return op, fmt.Errorf("inflect: invalid number %q: %w", s, err)
+	}
+	if frac != "" {
+		op.V = len(frac)
+		if op.F, err = strconv.ParseUint(frac, 10, 64); err != nil {
+			return op, fmt.Errorf("inflect: invalid number %q: %w", s, err)
+		}
+		trimmed := strings.TrimRight(frac, "0")
+		op.W = len(trimmed)
+		if trimmed != "" {
+			op.T, _ = strconv.ParseUint(trimmed, 10, 64)
+		}
+	}
+	op.N, _ = strconv.ParseFloat(num, 64)
+	return op, nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// intN returns n if it's a whole number, e.g. for 3 and 3.0
+func (op *Operands) intN() (uint64, bool) {
+	return op.I, op.T == 0
+}
+
+// nIn returns true if n is a whole number in range lo..hi
+func (op *Operands) nIn(lo, hi uint64) bool {
+	n, ok := op.intN()
+	return ok && n >= lo && n <= hi
+}
+
+// nModIn returns true if n is a whole number and n % mod is in range lo..hi
+func (op *Operands) nModIn(mod, lo, hi uint64) bool {
+	n, ok := op.intN()
+	return ok && n%mod >= lo && n%mod <= hi
+}
+
+func inRange(n, lo, hi uint64) bool {
+	return n >= lo && n <= hi
+}
+
+// isMillions is the "many" rule of French, Italian, Portuguese and Spanish
+func (op *Operands) isMillions() bool {
+	return op.V == 0 && op.I != 0 && op.I%1000000 == 0
+}
+
+type pluralRule func(op *Operands) PluralForm
+
+func pluralOther(op *Operands) PluralForm {
+	return Other
+}
+
+// en, de, nl, sv, ...
+func pluralOneIntegerOne(op *Operands) PluralForm {
+	if op.I == 1 && op.V == 0 {
+		return One
+	}
+	return Other
+}
+
+// tr, hu, el, ...
+func pluralNOne(op *Operands) PluralForm {
+	if op.nIn(1, 1) {
+		return One
+	}
+	return Other
+}
+
+func pluralSpanish(op *Operands) PluralForm {
+	switch {
+	case op.nIn(1, 1):
+		return One
+	case op.isMillions():
+		return Many
+	}
+	return Other
+}
+
+// it, ca, pt-PT
+func pluralItalian(op *Operands) PluralForm {
+	switch {
+	case op.I == 1 && op.V == 0:
+		return One
+	case op.isMillions():
+		return Many
+	}
+	return Other
+}
+
+// fr, pt
+func pluralFrench(op *Operands) PluralForm {
+	switch {
+	case op.I <= 1:
+		return One
+	case op.isMillions():
+		return Many
+	}
+	return Other
+}
+
+// ru, uk
+func pluralRussian(op *Operands) PluralForm {
+	if op.V != 0 {
+		return Other
+	}
+	i10, i100 := op.I%10, op.I%100
+	switch {
+	case i10 == 1 && i100 != 11:
+		return One
+	case inRange(i10, 2, 4) && !inRange(i100, 12, 14):
+		return Few
+	}
+	return Many
+}
+
+func pluralBelarusian(op *Operands) PluralForm {
+	switch {
+	case op.nModIn(10, 1, 1) && !op.nModIn(100, 11, 11):
+		return One
+	case op.nModIn(10, 2, 4) && !op.nModIn(100, 12, 14):
+		return Few
+	case op.nModIn(10, 0, 0) || op.nModIn(10, 5, 9) || op.nModIn(100, 11, 14):
+		return Many
+	}
+	return Other
+}
+
+func pluralPolish(op *Operands) PluralForm {
+	if op.V != 0 {
+		return Other
+	}
+	i10, i100 := op.I%10, op.I%100
+	switch {
+	case op.I == 1:
+		return One
+	case inRange(i10, 2, 4) && !inRange(i100, 12, 14):
+		return Few
+	}
+	return Many
+}
+
+// cs, sk
+func pluralCzech(op *Operands) PluralForm {
+	switch {
+	case op.V != 0:
+		return Many
+	case op.I == 1:
+		return One
+	case inRange(op.I, 2, 4):
+		return Few
+	}
+	return Other
+}
+
+func pluralArabic(op *Operands) PluralForm {
+	switch {
+	case op.nIn(0, 0):
+		return Zero
+	case op.nIn(1, 1):
+		return One
+	case op.nIn(2, 2):
+		return Two
+	case op.nModIn(100, 3, 10):
+		return Few
+	case op.nModIn(100, 11, 99):
+		return Many
+	}
+	return Other
+}
+
+func pluralWelsh(op *Operands) PluralForm {
+	switch {
+	case op.nIn(0, 0):
+		return Zero
+	case op.nIn(1, 1):
+		return One
+	case op.nIn(2, 2):
+		return Two
+	case op.nIn(3, 3):
+		return Few
+	case op.nIn(6, 6):
+		return Many
+	}
+	return Other
+}
+
+func pluralLithuanian(op *Operands) PluralForm {
+	switch {
+	case op.nModIn(10, 1, 1) && !op.nModIn(100, 11, 19):
+		return One
+	case op.nModIn(10, 2, 9) && !op.nModIn(100, 11, 19):
+		return Few
+	case op.F != 0:
+		return Many
+	}
+	return Other
+}
+
+func pluralLatvian(op *Operands) PluralForm {
+	f10, f100 := op.F%10, op.F%100
+	switch {
+	case op.nModIn(10, 0, 0) || op.nModIn(100, 11, 19) || (op.V == 2 && inRange(f100, 11, 19)):
+		return Zero
+	case (op.nModIn(10, 1, 1) && !op.nModIn(100, 11, 11)) ||
+		(op.V == 2 && f10 == 1 && f100 != 11) ||
+		(op.V != 2 && f10 == 1):
+		return One
+	}
+	return Other
+}
+
+func pluralIrish(op *Operands) PluralForm {
+	switch {
+	case op.nIn(1, 1):
+		return One
+	case op.nIn(2, 2):
+		return Two
+	case op.nIn(3, 6):
+		return Few
+	case op.nIn(7, 10):
+		return Many
+	}
+	return Other
+}
+
+func pluralSlovenian(op *Operands) PluralForm {
+	i100 := op.I % 100
+	switch {
+	case op.V == 0 && i100 == 1:
+		return One
+	case op.V == 0 && i100 == 2:
+		return Two
+	case (op.V == 0 && inRange(i100, 3, 4)) || op.V != 0:
+		return Few
+	}
+	return Other
+}
+
+func pluralDanish(op *Operands) PluralForm {
+	if op.nIn(1, 1) || (op.T != 0 && op.I <= 1) {
+		return One
+	}
+	return Other
+}
+
+// hi, bn, gu, kn, ...
+func pluralHindi(op *Operands) PluralForm {
+	if op.I == 0 || op.nIn(1, 1) {
+		return One
+	}
+	return Other
+}
+
+func pluralFilipino(op *Operands) PluralForm {
+	i10, f10 := op.I%10, op.F%10
+	if (op.V == 0 && inRange(op.I, 1, 3)) ||
+		(op.V == 0 && i10 != 4 && i10 != 6 && i10 != 9) ||
+		(op.V != 0 && f10 != 4 && f10 != 6 && f10 != 9) {
+		return One
+	}
+	return Other
+}
+
+// hr, sr, bs
+func pluralCroatian(op *Operands) PluralForm {
+	i10, i100 := op.I%10, op.I%100
+	f10, f100 := op.F%10, op.F%100
+	switch {
+	case (op.V == 0 && i10 == 1 && i100 != 11) || (f10 == 1 && f100 != 11):
+		return One
+	case (op.V == 0 && inRange(i10, 2, 4) && !inRange(i100, 12, 14)) ||
+		(inRange(f10, 2, 4) && !inRange(f100, 12, 14)):
+		return Few
+	}
+	return Other
+}
+
+func pluralHebrew(op *Operands) PluralForm {
+	switch {
+	case (op.I == 1 && op.V == 0) || (op.I == 0 && op.V != 0):
+		return One
+	case op.I == 2 && op.V == 0:
+		return Two
+	}
+	return Other
+}
+
+func pluralRomanian(op *Operands) PluralForm {
+	switch {
+	case op.I == 1 && op.V == 0:
+		return One
+	case op.V != 0 || op.nIn(0, 0) || (!op.nIn(1, 1) && op.nModIn(100, 1, 19)):
+		return Few
+	}
+	return Other
+}
+
+// CLDR plural rules by language
+var pluralRules = map[string]pluralRule{
+	"af":    pluralNOne,
+	"ar":    pluralArabic,
+	"be":    pluralBelarusian,
+	"bg":    pluralNOne,
+	"bn":    pluralHindi,
+	"bs":    pluralCroatian,
+	"ca":    pluralItalian,
+	"cs":    pluralCzech,
+	"cy":    pluralWelsh,
+	"da":    pluralDanish,
+	"de":    pluralOneIntegerOne,
+	"el":    pluralNOne,
+	"en":    pluralOneIntegerOne,
+	"es":    pluralSpanish,
+	"et":    pluralOneIntegerOne,
+	"fa":    pluralHindi,
+	"fi":    pluralOneIntegerOne,
+	"fil":   pluralFilipino,
+	"fr":    pluralFrench,
+	"ga":    pluralIrish,
+	"gu":    pluralHindi,
+	"he":    pluralHebrew,
+	"hi":    pluralHindi,
+	"hr":    pluralCroatian,
+	"hu":    pluralNOne,
+	"id":    pluralOther,
+	"it":    pluralItalian,
+	"ja":    pluralOther,
+	"kn":    pluralHindi,
+	"ko":    pluralOther,
+	"lt":    pluralLithuanian,
+	"lv":    pluralLatvian,
+	"ms":    pluralOther,
+	"nb":    pluralNOne,
+	"nl":    pluralOneIntegerOne,
+	"no":    pluralNOne,
+	"pl":    pluralPolish,
+	"pt":    pluralFrench,
+	"pt-pt": pluralItalian,
+	"ro":    pluralRomanian,
+	"ru":    pluralRussian,
+	"sk":    pluralCzech,
+	"sl":    pluralSlovenian,
+	"sr":    pluralCroatian,
+	"sv":    pluralOneIntegerOne,
+	"th":    pluralOther,
+	"tl":    pluralFilipino,
+	"tr":    pluralNOne,
+	"uk":    pluralRussian,
+	"vi":    pluralOther,
+	"zh":    pluralOther,
+}
+
+// pluralRuleFor returns the rule for a locale like "pt-PT", "pt_BR" or
+// "en", falling back to the language and then to a rule with only Other
+func pluralRuleFor(locale string) pluralRule {
+	tag := normalizeTag(locale)
+	for {
+		if rule, ok := pluralRules[tag]; ok {
+			return rule
+		}
+		var ok bool
+		if tag, ok = parentTag(tag); !ok {
+			return pluralOther
+		}
+	}
+}
+
+// PluralCategory returns the CLDR plural category of n in a locale like
+// "en", "pl" or "pt-PT". Unknown locales only have category Other.
+func PluralCategory(locale string, n int64) PluralForm {
+	u := abs(n)
+	op := Operands{N: float64(u), I: u}
+	return pluralRuleFor(locale)(&op)
+}
+
+// PluralCategoryOf returns the CLDR plural category of a number given by
+// its operands, which matters for fractions, e.g. in English "1 file" is
+// One but "1.0 files" is Other.
+func PluralCategoryOf(locale string, op Operands) PluralForm {
+	return pluralRuleFor(locale)(&op)
+}
@@ -0,0 +1,239 @@
+package inflect
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// CLDR sample values by locale and plural category
+var pluralSamples = map[string]map[PluralForm]string{
+	"en": {
+		One:   "1",
+		Other: "0, 2~16, 100, 1000, 10000, 100000, 1000000, 0.0~1.5, 10.0, 100.0",
+	},
+	"it": {
+		One:   "1",
+		Many:  "1000000, 2000000",
+		Other: "0, 2~16, 100, 1000, 10000, 100000, 0.0~1.5, 10.0, 100.0",
+	},
+	"es": {
+		One:   "1, 1.0, 1.00",
+		Many:  "1000000",
+		Other: "0, 2~16, 100, 1000, 10000, 100000, 0.0~0.9, 1.1~1.6, 10.0, 100.0, 1000000.0",
+	},
+	"fr": {
+		One:   "0, 1, 0.0~1.5",
+		Many:  "1000000",
+		Other: "2~17, 100, 1000, 10000, 100000, 2.0~3.5, 10.0, 100.0",
+	},
+	"pt": {
+		One:   "0, 1, 0.0~1.5",
+		Many:  "1000000",
+		Other: "2~17, 100, 1000, 10000, 100000, 2.0~3.5, 10.0, 100.0",
+	},
+	"pt-PT": {
+		One:   "1",
+		Many:  "1000000",
+		Other: "0, 2~16, 100, 1000, 10000, 100000, 0.0~1.5, 10.0, 100.0",
+	},
+	"ru": {
+		One:   "1, 21, 31, 41, 51, 61, 71, 81, 101, 1001",
+		Few:   "2~4, 22~24, 32~34, 42~44, 52~54, 62, 102, 1002",
+		Many:  "0, 5~19, 100, 1000, 10000, 100000, 1000000",
+		Other: "0.0~1.5, 10.0, 100.0, 1000.0",
+	},
+	"be": {
+		One:   "1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, 1.0, 21.0, 31.0",
+		Few:   "2~4, 22~24, 32~34, 102, 1002, 2.0, 3.0, 4.0, 22.0",
+		Many:  "0, 5~19, 100, 1000, 10000, 0.0, 5.0, 11.0, 100.0",
+		Other: "0.1~0.9, 1.1~1.7, 10.1, 100.1",
+	},
+	"pl": {
+		One:   "1",
+		Few:   "2~4, 22~24, 32~34, 42~44, 102, 1002",
+		Many:  "0, 5~19, 100, 1000, 10000, 100000, 1000000",
+		Other: "0.0~1.5, 10.0, 100.0",
+	},
+	"cs": {
+		One:   "1",
+		Few:   "2~4",
+		Many:  "0.0~1.5, 10.0, 100.0",
+		Other: "0, 5~19, 100, 1000, 10000",
+	},
+	"ar": {
+		Zero:  "0, 0.0, 0.00",
+		One:   "1, 1.0, 1.00",
+		Two:   "2, 2.0, 2.00",
+		Few:   "3~10, 103~110, 1003, 3.0, 4.0",
+		Many:  "11~26, 111, 1011, 11.0, 12.0",
+		Other: "100~102, 200~202, 300~302, 1000, 10000, 100000, 0.1~0.9, 1.1~1.7, 10.1",
+	},
+	"cy": {
+		Zero:  "0, 0.0",
+		One:   "1, 1.0",
+		Two:   "2, 2.0",
+		Few:   "3, 3.0",
+		Many:  "6, 6.0",
+		Other: "4, 5, 7~20, 100, 1000, 0.1~0.9, 1.1~1.7, 10.0",
+	},
+	"lt": {
+		One:   "1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, 1.0, 21.0",
+		Few:   "2~9, 22~29, 102, 1002, 2.0, 3.0",
+		Many:  "0.1~0.9, 1.1~1.7, 10.1",
+		Other: "0, 10~20, 30, 40, 50, 60, 100, 1000, 0.0, 10.0, 11.0",
+	},
+	"lv": {
+		Zero:  "0, 10~20, 30, 40, 50, 60, 100, 1000, 0.0, 10.0, 11.0, 0.00, 0.11",
+		One:   "1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, 0.1, 1.0, 1.1, 2.1, 0.01, 0.21",
+		Other: "2~9, 22~29, 102, 1002, 0.2~0.9, 1.2~1.9, 10.2",
+	},
+	"ga": {
+		One:   "1, 1.0",
+		Two:   "2, 2.0",
+		Few:   "3~6, 3.0",
+		Many:  "7~10, 7.0",
+		Other: "0, 11~25, 100, 0.0~0.9, 1.1~1.6",
+	},
+	"sl": {
+		One:   "1, 101, 201, 301, 1001",
+		Two:   "2, 102, 202, 1002",
+		Few:   "3, 4, 103, 104, 203, 0.0~1.5, 10.0",
+		Other: "0, 5~19, 100, 1000, 10000",
+	},
+	"da": {
+		One:   "1, 0.1~1.6",
+		Other: "0, 2~16, 100, 1000, 0.0, 2.0~3.4, 10.0",
+	},
+	"hi": {
+		One:   "0, 1, 0.0~1.0, 0.00~0.04",
+		Other: "2~17, 100, 1000, 1.1~2.6, 10.0",
+	},
+	"fil": {
+		One:   "0~3, 5, 7, 8, 10~13, 15, 17, 18, 20, 21, 100, 1000, 0.0~0.3, 0.5, 0.7, 0.8, 1.0~1.3, 1.5",
+		Other: "4, 6, 9, 14, 16, 19, 24, 26, 104, 1004, 0.4, 0.6, 0.9, 1.4",
+	},
+	"hr": {
+		One:   "1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, 0.1, 1.1, 2.1, 10.1",
+		Few:   "2~4, 22~24, 32~34, 102, 1002, 0.2~0.4, 1.2~1.4, 2.2~2.4",
+		Other: "0, 5~19, 100, 1000, 0.0, 0.5~1.0, 1.5~2.0, 10.0",
+	},
+	"he": {
+		One:   "1, 0.0~0.9",
+		Two:   "2",
+		Other: "0, 3~17, 100, 1000, 1.0~1.5, 10.0",
+	},
+	"ro": {
+		One:   "1",
+		Few:   "0, 2~16, 101, 1001, 0.0~1.5, 10.0",
+		Other: "20~35, 100, 1000, 10000",
+	},
+	"tr": {
+		One:   "1, 1.0, 1.00",
+		Other: "0, 2~16, 100, 0.0~0.9, 1.1~1.6",
+	},
+	"ja": {
+		Other: "0~15, 100, 1000, 0.0~1.5",
+	},
+}
+
+// expandSamples expands ranges like "2~4" and "0.0~0.3" in CLDR samples
+func expandSamples(t *testing.T, samples string) []string {
+	var res []string
+	for _, s := range strings.Split(samples, ", ") {
+		parts := strings.Split(s, "~")
+		if len(parts) == 1 {
+			res = append(res, s)
+			continue
+		}
+		decimals := 0
+		if i := strings.IndexByte(parts[0], '.'); i >= 0 {
+			decimals = len(parts[0]) - i - 1
+		}
+		scaled := func(s string) int {
+			n, err := strconv.Atoi(strings.Replace(s, ".", "", 1))
+			assert.NoError(t, err)
+			return n
+		}
+		lo, hi := scaled(parts[0]), scaled(parts[1])
+		for n := lo; n <= hi; n++ {
+			if decimals == 0 {
+				res = append(res, strconv.Itoa(n))
+				continue
+			}
+			s := fmt.Sprintf("%0*d", decimals+1, n)
+			res = append(res, s[:len(s)-decimals]+"."+s[len(s)-decimals:])
+		}
+	}
+	return res
+}
+
+func TestPluralCategorySamples(t *testing.T) {
+	// languages with the same rules
+	pluralSamples["de"] = pluralSamples["en"]
+	pluralSamples["nl"] = pluralSamples["en"]
+	pluralSamples["sv"] = pluralSamples["en"]
+	pluralSamples["uk"] = pluralSamples["ru"]
+	pluralSamples["sk"] = pluralSamples["cs"]
+	pluralSamples["sr"] = pluralSamples["hr"]
+	pluralSamples["bs"] = pluralSamples["hr"]
+	pluralSamples["hu"] = pluralSamples["tr"]
+	pluralSamples["zh"] = pluralSamples["ja"]
+	pluralSamples["ko"] = pluralSamples["ja"]
+
+	for locale, categories := range pluralSamples {
+		for form, samples := range categories {
+			for _, s := range expandSamples(t, samples) {
+				op, err := ParseOperands(s)
+				assert.NoError(t, err)
+				assert.Equal(t, form, PluralCategoryOf(locale, op), "locale: %s, n: %s", locale, s)
+				if !strings.Contains(s, ".") {
+					n, _ := strconv.ParseInt(s, 10, 64)
+					assert.Equal(t, form, PluralCategory(locale, n), "locale: %s, n: %s", locale, s)
+					assert.Equal(t, form, PluralCategory(locale, -n), "locale: %s, n: -%s", locale, s)
+				}
+			}
+		}
+	}
+}
+
+func TestPluralCategoryLocales(t *testing.T) {
+	assert.Equal(t, Many, PluralCategory("pt-PT", 1000000))
+	assert.Equal(t, Other, PluralCategory("pt-PT", 0))
+	assert.Equal(t, One, PluralCategory("pt_BR", 0))
+	assert.Equal(t, One, PluralCategory("pt_pt", 1))
+	assert.Equal(t, Few, PluralCategory("ru-RU", 3))
+	assert.Equal(t, Few, PluralCategory("sr-Latn-RS", 3))
+	assert.Equal(t, Other, PluralCategory("zh-Hant-TW", 1))
+	assert.Equal(t, Other, PluralCategory("xx", 1))
+	assert.Equal(t, Other, PluralCategory("", 1))
+	assert.Equal(t, Many, PluralCategory("pl", -9223372036854775808))
+}
+
+func TestParseOperands(t *testing.T) {
+	op, err := ParseOperands("1.50")
+	assert.NoError(t, err)
+	assert.Equal(t, Operands{N: 1.5, I: 1, V: 2, W: 1, F: 50, T: 5}, op)
+
+	op, err = ParseOperands("-12")
+	assert.NoError(t, err)
+	assert.Equal(t, Operands{N: 12, I: 12}, op)
+
+	op, err = ParseOperands("0.00")
+	assert.NoError(t, err)
+	assert.Equal(t, Operands{N: 0, I: 0, V: 2}, op)
+
+	for _, s := range []string{"", "-", "1.", ".5", "abc", "1e3", "--1", "+1", "1,5", "99999999999999999999"} {
+		_, err := ParseOperands(s)
+		assert.Error(t, err, "s: %q", s)
+	}
+}
+
+func TestPluralFormString(t *testing.T) {
+	assert.Equal(t, "few", Few.String())
+	assert.Equal(t, "other", Other.String())
+	assert.Equal(t, "unknown", PluralForm(42).String())
+}
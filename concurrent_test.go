@@ -0,0 +1,90 @@
+package inflect
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// those tests are meant to be run with -race
+
+func TestConcurrentInflect(t *testing.T) {
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, test := range basicTests {
+				assert.Equal(t, test[1], ToPlural(test[0]))
+				assert.Equal(t, test[0], ToSingular(test[1]))
+				assert.True(t, IsPlural(test[1]))
+				assert.True(t, IsSingular(test[0]))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestConcurrentAddRules(t *testing.T) {
+	in := New()
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 25; i++ {
+				word := fmt.Sprintf("word%dx%d", g, i)
+				in.AddIrregularRule(word, word+"zz")
+				in.AddUncountableRule("uncountable" + word)
+				in.AddPluralRule(fmt.Sprintf("/%sq$/i", word), "$0s")
+				in.AddSingularRule(fmt.Sprintf("/%sqs$/i", word), word+"q")
+			}
+		}(g)
+	}
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				assert.Equal(t, "people", in.ToPlural("person"))
+				assert.Equal(t, "person", in.ToSingular("people"))
+				assert.True(t, in.IsPlural("sheep"))
+				assert.True(t, in.IsSingular("man"))
+				assert.Equal(t, "3 cats", in.Pluralize("cat", 3, true))
+			}
+		}()
+	}
+	wg.Wait()
+
+	// all updates are visible once writers are done
+	for g := 0; g < 4; g++ {
+		for i := 0; i < 25; i++ {
+			word := fmt.Sprintf("word%dx%d", g, i)
+			assert.Equal(t, word+"zz", in.ToPlural(word))
+			assert.Equal(t, word, in.ToSingular(word+"zz"))
+			assert.Equal(t, "uncountable"+word, in.ToPlural("uncountable"+word))
+			assert.Equal(t, word+"qs", in.ToPlural(word+"q"))
+			assert.Equal(t, word+"q", in.ToSingular(word+"qs"))
+		}
+	}
+}
+
+func TestConcurrentInflectorsAreIndependent(t *testing.T) {
+	a, b := New(), New()
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		a.AddUncountableRule("paper")
+	}()
+	go func() {
+		defer wg.Done()
+		b.AddIrregularRule("paper", "paperz")
+	}()
+	wg.Wait()
+	assert.Equal(t, "paper", a.ToPlural("paper"))
+	assert.Equal(t, "paperz", b.ToPlural("paper"))
+	assert.Equal(t, "papers", ToPlural("paper"))
+}
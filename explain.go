@@ -0,0 +1,74 @@
+package inflect
+
+// Source tells what decided the result of an inflection.
+type Source int
+
+const (
+	// SourceNone means that no rule matched and the word is unchanged.
+	SourceNone Source = iota
+	// SourceUncountable means that the word is in the uncountable set.
+	SourceUncountable
+	// SourceIrregular means that the word is in the irregular map.
+	SourceIrregular
+	// SourceRule means that a regexp rule matched. Uncountable rules given
+	// as regexps, like `/fish$/i`, are also reported as SourceRule.
+	SourceRule
+	// SourceAcronym means that the word is a registered acronym, like "URL".
+	SourceAcronym
+)
+
+var sourceNames = []string{"none", "uncountable", "irregular", "rule", "acronym"}
+
+func (s Source) String() string {
+	if s >= 0 && int(s) < len(sourceNames) {
+		return sourceNames[s]
+	}
+	return "unknown"
+}
+
+// Inflection describes how a word was pluralized or singularized.
+type Inflection struct {
+	Result string
+	Source Source
+
+	// Only set when Source is SourceRule.
+	RuleIndex   int    // index of the rule in the plural or singular rule list, -1 if none
+	Rule        string // the rule as given, e.g. `/(matr)ix$/i`
+	RuleGo      string // the rule translated to Go regexp syntax
+	Replacement string // the replacement as given, e.g. `$1ices`
+}
+
+func (ex *Inflection) explainRule(idx int, rule rxRule) {
+	ex.Source = SourceRule
+	ex.RuleIndex = idx
+	ex.Rule = rule.rxStrJs
+	ex.RuleGo = rule.rxStrGo
+	ex.Replacement = rule.replacementJs
+}
+
+// Explanation describes how a word is pluralized and singularized.
+type Explanation struct {
+	Word     string
+	Plural   Inflection
+	Singular Inflection
+}
+
+// Explain tells which rules produce the plural and singular form of a word.
+// It's meant for debugging surprising results.
+func (in *Inflector) Explain(word string) Explanation {
+	rs := in.load()
+	res := Explanation{
+		Word:     word,
+		Plural:   Inflection{RuleIndex: -1},
+		Singular: Inflection{RuleIndex: -1},
+	}
+	res.Plural.Result = rs.inflectWord(word, true, &res.Plural)
+	res.Singular.Result = rs.inflectWord(word, false, &res.Singular)
+	return res
+}
+
+// Explain tells which of the default rules produce the plural and singular
+// form of a word.
+func Explain(word string) Explanation {
+	return defaultInflector.Explain(word)
+}
@@ -0,0 +1,71 @@
+package inflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplain(t *testing.T) {
+	ex := Explain("matrix")
+	assert.Equal(t, "matrix", ex.Word)
+	assert.Equal(t, "matrices", ex.Plural.Result)
+	assert.Equal(t, SourceRule, ex.Plural.Source)
+	assert.Equal(t, `/(matr|cod|mur|sil|vert|ind|append)(?:ix|ex)$/i`, ex.Plural.Rule)
+	assert.Equal(t, `(?i)(matr|cod|mur|sil|vert|ind|append)(?:ix|ex)$`, ex.Plural.RuleGo)
+	assert.Equal(t, `$1ices`, ex.Plural.Replacement)
+	r := defaultInflector.load().plural.rules[ex.Plural.RuleIndex]
+	assert.Equal(t, ex.Plural.Rule, r.rxStrJs)
+	assert.Equal(t, "matrix", ex.Singular.Result)
+	assert.Equal(t, `/(x|ch|ss|sh|zz|tto|go|cho|alias|[^aou]us|t[lm]as|gas|(?:her|at|gr)o|ris)(?:es)?$/i`, ex.Singular.Rule)
+
+	ex = Explain("cat")
+	assert.Equal(t, "cat", ex.Singular.Result)
+	assert.Equal(t, SourceNone, ex.Singular.Source)
+	assert.Equal(t, -1, ex.Singular.RuleIndex)
+
+	ex = Explain("Sheep")
+	assert.Equal(t, "Sheep", ex.Plural.Result)
+	assert.Equal(t, SourceRule, ex.Plural.Source)
+	assert.Equal(t, `/sheep$/i`, ex.Plural.Rule)
+
+	ex = Explain("News")
+	assert.Equal(t, "News", ex.Plural.Result)
+	assert.Equal(t, SourceUncountable, ex.Plural.Source)
+	assert.Equal(t, SourceUncountable, ex.Singular.Source)
+	assert.Equal(t, "", ex.Plural.Rule)
+
+	ex = Explain("people")
+	assert.Equal(t, "people", ex.Plural.Result)
+	assert.Equal(t, SourceRule, ex.Plural.Source)
+	assert.Equal(t, "person", ex.Singular.Result)
+	assert.Equal(t, SourceRule, ex.Singular.Source)
+
+	ex = Explain("Tooth")
+	assert.Equal(t, "Teeth", ex.Plural.Result)
+	assert.Equal(t, SourceIrregular, ex.Plural.Source)
+	assert.Equal(t, "Tooth", ex.Singular.Result)
+	assert.Equal(t, SourceIrregular, ex.Singular.Source)
+
+	ex = Explain("")
+	assert.Equal(t, SourceNone, ex.Plural.Source)
+	assert.Equal(t, "irregular", SourceIrregular.String())
+	assert.Equal(t, "unknown", Source(42).String())
+}
+
+func TestExplainMatchesInflection(t *testing.T) {
+	for i, test := range basicTests {
+		ex := Explain(test[0])
+		assert.Equal(t, ToPlural(test[0]), ex.Plural.Result, "s: %s, i: %d", test[0], i)
+		assert.Equal(t, ToSingular(test[0]), ex.Singular.Result, "s: %s, i: %d", test[0], i)
+	}
+}
+
+func TestExplainCustomRule(t *testing.T) {
+	in := New()
+	in.AddPluralRule(`/gex$/i`, "gexii")
+	ex := in.Explain("regex")
+	assert.Equal(t, "regexii", ex.Plural.Result)
+	assert.Equal(t, len(in.load().plural.rules)-1, ex.Plural.RuleIndex)
+	assert.Equal(t, "gexii", ex.Plural.Replacement)
+}
@@ -0,0 +1,100 @@
+package inflect
+
+var frenchIrregularRules = [][]string{
+	{"œil", "yeux"},
+	{"ciel", "cieux"},
+	{"aïeul", "aïeux"},
+	{"monsieur", "messieurs"},
+	{"madame", "mesdames"},
+	{"mademoiselle", "mesdemoiselles"},
+	// Words ending in `ail` that become `aux`.
+	{"bail", "baux"},
+	{"corail", "coraux"},
+	{"émail", "émaux"},
+	{"soupirail", "soupiraux"},
+	{"travail", "travaux"},
+	{"vantail", "vantaux"},
+	{"vitrail", "vitraux"},
+	// Words ending in `al` that only add `s`.
+	{"bal", "bals"},
+	{"carnaval", "carnavals"},
+	{"chacal", "chacals"},
+	{"festival", "festivals"},
+	{"récital", "récitals"},
+	{"régal", "régals"},
+	// Words ending in `au` that add `x`, whose plurals could be taken for
+	// plurals of words ending in `al`.
+	{"étau", "étaux"},
+	{"fléau", "fléaux"},
+	{"gruau", "gruaux"},
+	{"préau", "préaux"},
+	// Words ending in `au` and `eu` that only add `s`.
+	{"landau", "landaus"},
+	{"sarrau", "sarraus"},
+	{"bleu", "bleus"},
+	{"pneu", "pneus"},
+	{"émeu", "émeus"},
+}
+
+var frenchPluralizationRules = [][]string{
+	{`/$/`, `s`},
+	{`/[sxz]$/i`, `$0`},
+	{`/(au|eu)$/i`, `$1x`},
+	{`/al$/i`, `aux`},
+	{`/\b(bij|caill|ch|gen|hib|jouj|p)ou$/i`, `$1oux`},
+}
+
+var frenchSingularizationRules = [][]string{
+	{`/s$/i`, ``},
+	{`/(au|eu)x$/i`, `$1`},
+	{`/aux$/i`, `al`},
+	{`/(eau|yau)x$/i`, `$1`},
+	{`/oux$/i`, `ou`},
+}
+
+// Words ending in `s`, `x` or `z` are the same in plural.
+var frenchUncountableRules = []string{
+	"avis",
+	"bois",
+	"bras",
+	"cas",
+	"choix",
+	"colis",
+	"corps",
+	"croix",
+	"dos",
+	"fils",
+	"fois",
+	"gaz",
+	"jus",
+	"mois",
+	"nez",
+	"noix",
+	"paix",
+	"pays",
+	"poids",
+	"prix",
+	"repas",
+	"riz",
+	"souris",
+	"tapis",
+	"temps",
+	"toux",
+	"voix",
+}
+
+var frenchTables = &ruleTables{
+	irregular:   frenchIrregularRules,
+	plural:      frenchPluralizationRules,
+	singular:    frenchSingularizationRules,
+	uncountable: frenchUncountableRules,
+}
+
+// NewFrench returns an Inflector initialized with French rules.
+func NewFrench() *Inflector {
+	return newInflector(frenchTables)
+}
+
+func init() {
+	Register("fr", NewFrench())
+}
@@ -0,0 +1,73 @@
+package inflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var frenchTests = [][]string{
+	// Regular.
+	{"chat", "chats"},
+	{"maison", "maisons"},
+	{"école", "écoles"},
+	{"ami", "amis"},
+	{"clou", "clous"},
+	{"rail", "rails"},
+	// `s`, `x` and `z` are invariant.
+	{"bras", "bras"},
+	{"prix", "prix"},
+	{"nez", "nez"},
+	{"pays", "pays"},
+	{"souris", "souris"},
+	// `au`, `eau` and `eu` add `x`.
+	{"bateau", "bateaux"},
+	{"gâteau", "gâteaux"},
+	{"tuyau", "tuyaux"},
+	{"noyau", "noyaux"},
+	{"fléau", "fléaux"},
+	{"étau", "étaux"},
+	{"préau", "préaux"},
+	{"jeu", "jeux"},
+	{"cheveu", "cheveux"},
+	{"feu", "feux"},
+	// `al` becomes `aux`.
+	{"cheval", "chevaux"},
+	{"journal", "journaux"},
+	{"animal", "animaux"},
+	{"hôpital", "hôpitaux"},
+	// `ou` words that add `x`.
+	{"bijou", "bijoux"},
+	{"caillou", "cailloux"},
+	{"chou", "choux"},
+	{"genou", "genoux"},
+	{"hibou", "hiboux"},
+	{"joujou", "joujoux"},
+	{"pou", "poux"},
+	// Irregular.
+	{"œil", "yeux"},
+	{"ciel", "cieux"},
+	{"monsieur", "messieurs"},
+	{"madame", "mesdames"},
+	{"travail", "travaux"},
+	{"vitrail", "vitraux"},
+	{"bal", "bals"},
+	{"festival", "festivals"},
+	{"landau", "landaus"},
+	{"pneu", "pneus"},
+	{"bleu", "bleus"},
+	// Case.
+	{"Cheval", "Chevaux"},
+	{"BATEAU", "BATEAUX"},
+	{"Œil", "Yeux"},
+	{"ÉCOLE", "ÉCOLES"},
+}
+
+func TestFrench(t *testing.T) {
+	testLanguage(t, NewFrench(), frenchTests)
+}
+
+func TestFrenchRegistered(t *testing.T) {
+	assert.Equal(t, "chevaux", For("fr").ToPlural("cheval"))
+	assert.Equal(t, "bijou", For("fr-CA").ToSingular("bijoux"))
+}
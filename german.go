@@ -0,0 +1,450 @@
+package inflect
+
+// Gender is the grammatical gender of a German noun, which its plural form
+// depends on.
+type Gender int
+
+const (
+	// UnknownGender uses rules that work for most nouns of any gender.
+	UnknownGender Gender = iota
+	// Masculine nouns, like der Tag.
+	Masculine
+	// Feminine nouns, like die Frau.
+	Feminine
+	// Neuter nouns, like das Jahr.
+	Neuter
+)
+
+var genderNames = []string{"unknown", "masculine", "feminine", "neuter"}
+
+func (g Gender) String() string {
+	if g >= 0 && int(g) < len(genderNames) {
+		return genderNames[g]
+	}
+	return "unknown"
+}
+
+var germanIrregularRules = [][]string{
+	// Umlaut and `er`.
+	{"Amt", "Ämter"},
+	{"Blatt", "Blätter"},
+	{"Buch", "Bücher"},
+	{"Dach", "Dächer"},
+	{"Dorf", "Dörfer"},
+	{"Fach", "Fächer"},
+	{"Glas", "Gläser"},
+	{"Gott", "Götter"},
+	{"Grab", "Gräber"},
+	{"Gras", "Gräser"},
+	{"Haus", "Häuser"},
+	{"Horn", "Hörner"},
+	{"Huhn", "Hühner"},
+	{"Kalb", "Kälber"},
+	{"Korn", "Körner"},
+	{"Lamm", "Lämmer"},
+	{"Land", "Länder"},
+	{"Loch", "Löcher"},
+	{"Mann", "Männer"},
+	{"Mund", "Münder"},
+	{"Rad", "Räder"},
+	{"Rand", "Ränder"},
+	{"Schloss", "Schlösser"},
+	{"Tal", "Täler"},
+	{"Tuch", "Tücher"},
+	{"Volk", "Völker"},
+	{"Wald", "Wälder"},
+	{"Wort", "Wörter"},
+	{"Wurm", "Würmer"},
+	// `er` only.
+	{"Bild", "Bilder"},
+	{"Brett", "Bretter"},
+	{"Ei", "Eier"},
+	{"Feld", "Felder"},
+	{"Geist", "Geister"},
+	{"Geld", "Gelder"},
+	{"Kind", "Kinder"},
+	{"Kleid", "Kleider"},
+	{"Licht", "Lichter"},
+	{"Lied", "Lieder"},
+	{"Rind", "Rinder"},
+	{"Schwert", "Schwerter"},
+	// Umlaut and `e`.
+	{"Arzt", "Ärzte"},
+	{"Bach", "Bäche"},
+	{"Ball", "Bälle"},
+	{"Bart", "Bärte"},
+	{"Bauch", "Bäuche"},
+	{"Baum", "Bäume"},
+	{"Brust", "Brüste"},
+	{"Faust", "Fäuste"},
+	{"Fluss", "Flüsse"},
+	{"Frosch", "Frösche"},
+	{"Frucht", "Früchte"},
+	{"Fuchs", "Füchse"},
+	{"Fuß", "Füße"},
+	{"Gans", "Gänse"},
+	{"Gast", "Gäste"},
+	{"Hals", "Hälse"},
+	{"Hand", "Hände"},
+	{"Haut", "Häute"},
+	{"Hof", "Höfe"},
+	{"Hut", "Hüte"},
+	{"Kamm", "Kämme"},
+	{"Koch", "Köche"},
+	{"Kopf", "Köpfe"},
+	{"Korb", "Körbe"},
+	{"Kraft", "Kräfte"},
+	{"Kuh", "Kühe"},
+	{"Kunst", "Künste"},
+	{"Laus", "Läuse"},
+	{"Luft", "Lüfte"},
+	{"Macht", "Mächte"},
+	{"Markt", "Märkte"},
+	{"Maus", "Mäuse"},
+	{"Nacht", "Nächte"},
+	{"Platz", "Plätze"},
+	{"Raum", "Räume"},
+	{"Rock", "Röcke"},
+	{"Saal", "Säle"},
+	{"Satz", "Sätze"},
+	{"Schrank", "Schränke"},
+	{"Schuss", "Schüsse"},
+	{"Sohn", "Söhne"},
+	{"Stadt", "Städte"},
+	{"Stuhl", "Stühle"},
+	{"Sturm", "Stürme"},
+	{"Topf", "Töpfe"},
+	{"Traum", "Träume"},
+	{"Turm", "Türme"},
+	{"Wand", "Wände"},
+	{"Wolf", "Wölfe"},
+	{"Wurst", "Würste"},
+	{"Zahn", "Zähne"},
+	{"Zaun", "Zäune"},
+	{"Zug", "Züge"},
+	// Umlaut only.
+	{"Apfel", "Äpfel"},
+	{"Boden", "Böden"},
+	{"Bruder", "Brüder"},
+	{"Faden", "Fäden"},
+	{"Garten", "Gärten"},
+	{"Graben", "Gräben"},
+	{"Hafen", "Häfen"},
+	{"Kloster", "Klöster"},
+	{"Laden", "Läden"},
+	{"Mantel", "Mäntel"},
+	{"Mutter", "Mütter"},
+	{"Nagel", "Nägel"},
+	{"Ofen", "Öfen"},
+	{"Sattel", "Sättel"},
+	{"Schaden", "Schäden"},
+	{"Tochter", "Töchter"},
+	{"Vater", "Väter"},
+	{"Vogel", "Vögel"},
+	// Masculine and neuter words with `en` or `n`.
+	{"Auge", "Augen"},
+	{"Bär", "Bären"},
+	{"Bauer", "Bauern"},
+	{"Bett", "Betten"},
+	{"Elefant", "Elefanten"},
+	{"Ende", "Enden"},
+	{"Held", "Helden"},
+	{"Hemd", "Hemden"},
+	{"Herr", "Herren"},
+	{"Herz", "Herzen"},
+	{"Insekt", "Insekten"},
+	{"Mensch", "Menschen"},
+	{"Nachbar", "Nachbarn"},
+	{"Ohr", "Ohren"},
+	{"Patient", "Patienten"},
+	{"Präsident", "Präsidenten"},
+	{"Schmerz", "Schmerzen"},
+	{"Staat", "Staaten"},
+	{"Student", "Studenten"},
+	// Masculine words ending in `e`.
+	{"Affe", "Affen"},
+	{"Buchstabe", "Buchstaben"},
+	{"Gedanke", "Gedanken"},
+	{"Hase", "Hasen"},
+	{"Junge", "Jungen"},
+	{"Kollege", "Kollegen"},
+	{"Kunde", "Kunden"},
+	{"Löwe", "Löwen"},
+	{"Name", "Namen"},
+	{"Neffe", "Neffen"},
+	// Feminine words ending in a consonant.
+	{"Antwort", "Antworten"},
+	{"Arbeit", "Arbeiten"},
+	{"Art", "Arten"},
+	{"Bahn", "Bahnen"},
+	{"Burg", "Burgen"},
+	{"Fahrt", "Fahrten"},
+	{"Form", "Formen"},
+	{"Last", "Lasten"},
+	{"Person", "Personen"},
+	{"Pflicht", "Pflichten"},
+	{"Schrift", "Schriften"},
+	{"Schuld", "Schulden"},
+	{"Tat", "Taten"},
+	{"Tür", "Türen"},
+	{"Uhr", "Uhren"},
+	{"Wahl", "Wahlen"},
+	{"Welt", "Welten"},
+	{"Zahl", "Zahlen"},
+	{"Zeit", "Zeiten"},
+	// Feminine words ending in `e`, which would be taken for plurals.
+	{"Blume", "Blumen"},
+	{"Brücke", "Brücken"},
+	{"Farbe", "Farben"},
+	{"Frage", "Fragen"},
+	{"Grenze", "Grenzen"},
+	{"Katze", "Katzen"},
+	{"Kirche", "Kirchen"},
+	{"Küche", "Küchen"},
+	{"Lampe", "Lampen"},
+	{"Pflanze", "Pflanzen"},
+	{"Reise", "Reisen"},
+	{"Sache", "Sachen"},
+	{"Schule", "Schulen"},
+	{"Seite", "Seiten"},
+	{"Sonne", "Sonnen"},
+	{"Sprache", "Sprachen"},
+	{"Straße", "Straßen"},
+	{"Stunde", "Stunden"},
+	{"Tasche", "Taschen"},
+	{"Tasse", "Tassen"},
+	{"Woche", "Wochen"},
+	// Feminine words ending in `el` or `er`.
+	{"Ampel", "Ampeln"},
+	{"Feder", "Federn"},
+	{"Gabel", "Gabeln"},
+	{"Insel", "Inseln"},
+	{"Kartoffel", "Kartoffeln"},
+	{"Mauer", "Mauern"},
+	{"Nadel", "Nadeln"},
+	{"Nummer", "Nummern"},
+	{"Regel", "Regeln"},
+	{"Schwester", "Schwestern"},
+	{"Tafel", "Tafeln"},
+	{"Zwiebel", "Zwiebeln"},
+	// Words from Latin and Greek.
+	{"Album", "Alben"},
+	{"Atlas", "Atlanten"},
+	{"Datum", "Daten"},
+	{"Drama", "Dramen"},
+	{"Firma", "Firmen"},
+	{"Globus", "Globen"},
+	{"Kaktus", "Kakteen"},
+	{"Lexikon", "Lexika"},
+	{"Museum", "Museen"},
+	{"Praktikum", "Praktika"},
+	{"Rhythmus", "Rhythmen"},
+	{"Studium", "Studien"},
+	{"Thema", "Themen"},
+	{"Virus", "Viren"},
+	{"Visum", "Visa"},
+	{"Zentrum", "Zentren"},
+	// Other irregular rules.
+	{"Bus", "Busse"},
+	{"Floß", "Flöße"},
+	{"Gas", "Gase"},
+	{"Kaffee", "Kaffees"},
+	{"Restaurant", "Restaurants"},
+	{"Stern", "Sterne"},
+	{"Tee", "Tees"},
+}
+
+// Default rules, which depend on the gender and have lower precedence than
+// germanPluralizationRules.
+var germanGenderPluralizationRules = [...][][]string{
+	UnknownGender: {
+		{`/$/`, `e`},
+		{`/e$/i`, `en`},
+		{`/([^aeiouäöü])e$/i`, `$1e`},
+		{`/(..ag|ell|[^e]in|os|ur)e$/i`, `$1en`},
+		{`/(el|er|en)$/i`, `$1`},
+		{`/([aiouy])$/i`, `$1s`},
+		{`/([^e]i|[aoy])s$/i`, `$1s`},
+		{`/au$/i`, `auen`},
+		{`/(ent|ant)$/i`, `$1en`},
+		{`/([^e])in$/i`, `$1innen`},
+	},
+	Masculine: {
+		{`/$/`, `e`},
+		{`/e$/i`, `en`},
+		{`/(el|er|en)$/i`, `$1`},
+		{`/([aiouy])$/i`, `$1s`},
+		{`/([^e]i|[aoy])s$/i`, `$1s`},
+		{`/(ent|ant)$/i`, `$1en`},
+	},
+	Feminine: {
+		{`/$/`, `en`},
+		{`/e$/i`, `en`},
+		{`/(el|er)$/i`, `$1n`},
+		{`/([aiouy])$/i`, `$1s`},
+		{`/([^e]i|[aoy])s$/i`, `$1s`},
+		{`/au$/i`, `auen`},
+		{`/in$/i`, `innen`},
+	},
+	Neuter: {
+		{`/$/`, `e`},
+		{`/e$/i`, `en`},
+		{`/(el|er|en)$/i`, `$1`},
+		{`/([aiouy])$/i`, `$1s`},
+		{`/([^e]i|[aoy])s$/i`, `$1s`},
+	},
+}
+
+var germanPluralizationRules = [][]string{
+	{`/(ung|heit|keit|schaft|ion|tät|ei|ur|ik)$/i`, `$1en`},
+	{`/(er|ist|ent|ant)in$/i`, `$1innen`},
+	{`/nis(se)?$/i`, `nisse`},
+	{`/ismus$/i`, `ismen`},
+	{`/ist$/i`, `isten`},
+	{`/eum$/i`, `een`},
+	{`/ium$/i`, `ien`},
+	{`/tum$/i`, `tümer`},
+	{`/(chen|lein)$/i`, `$1`},
+	{`/ier$/i`, `iere`},
+	{`/ment$/i`, `mente`},
+}
+
+// Default rules, which depend on the gender and have lower precedence than
+// germanSingularizationRules.
+var germanGenderSingularizationRules = [...][][]string{
+	UnknownGender: {
+		{`/([^aeiouäöü])e$/i`, `$1`},
+		{`/(..ag|ell|[^e]in|os|ur)e$/i`, `$1e`},
+		{`/en$/i`, `e`},
+		{`/([aeiouäöü])en$/i`, `$1`},
+		{`/(e[lr])n$/i`, `$1`},
+		{`/([^e]i|[aoy])s$/i`, `$1`},
+		{`/(ent|ant)en$/i`, `$1`},
+	},
+	Masculine: {
+		{`/e$/i`, ``},
+		{`/([^e]i|[aoy])s$/i`, `$1`},
+		{`/(ent|ant)en$/i`, `$1`},
+	},
+	Feminine: {
+		{`/en$/i`, `e`},
+		{`/([aeiouäöü])en$/i`, `$1`},
+		{`/(e[lr])n$/i`, `$1`},
+		{`/([^e]i|[aoy])s$/i`, `$1`},
+	},
+	Neuter: {
+		{`/e$/i`, ``},
+		{`/([^e]i|[aoy])s$/i`, `$1`},
+	},
+}
+
+var germanSingularizationRules = [][]string{
+	{`/(ee|ie)n$/i`, `$1`},
+	{`/(ung|heit|keit|schaft|ion|tät|ei|ur|ik)en$/i`, `$1`},
+	{`/innen$/i`, `in`},
+	{`/nis(se)?$/i`, `nis`},
+	{`/ismen$/i`, `ismus`},
+	{`/isten$/i`, `ist`},
+	{`/tümer$/i`, `tum`},
+	{`/(chen|lein)$/i`, `$1`},
+}
+
+var germanUncountableRules = []string{
+	"Ananas",
+	"Chaos",
+	"Gebirge",
+	"Gemüse",
+	"Käse",
+	"Knie",
+	"Kosmos",
+}
+
+// germanTables are the rules for each Gender.
+var germanTables [Neuter + 1]*ruleTables
+
+func init() {
+	for g := range germanTables {
+		var plural, singular [][]string
+		plural = append(plural, germanGenderPluralizationRules[g]...)
+		plural = append(plural, germanPluralizationRules...)
+		singular = append(singular, germanGenderSingularizationRules[g]...)
+		singular = append(singular, germanSingularizationRules...)
+		germanTables[g] = &ruleTables{
+			irregular:   germanIrregularRules,
+			plural:      plural,
+			singular:    singular,
+			uncountable: germanUncountableRules,
+		}
+	}
+}
+
+// German inflects German nouns. Plurals depend on the gender of a noun,
+// which can be given as a hint. Without it, words are inflected by rules
+// that work for most nouns, so e.g. "Frau" and "Tag" become "Frauen" and
+// "Tage", but "Schlacht" only becomes "Schlachten" if it's known to be
+// feminine. Words ending in a consonant and `e` are taken for plurals
+// unless they have a typically feminine ending or are in the dictionary.
+type German struct {
+	genders [Neuter + 1]*Inflector
+}
+
+var _ Language = (*German)(nil)
+
+// NewGerman returns a German inflector.
+func NewGerman() *German {
+	de := &German{}
+	for g := range de.genders {
+		de.genders[g] = newInflector(germanTables[g])
+	}
+	return de
+}
+
+// Gender returns the Inflector used for nouns of a gender, e.g. to add
+// rules that only apply to it.
+func (de *German) Gender(g Gender) *Inflector {
+	if g < 0 || int(g) >= len(de.genders) {
+		g = UnknownGender
+	}
+	return de.genders[g]
+}
+
+// AddIrregularRule adds an irregular word to the rules of all genders.
+func (de *German) AddIrregularRule(single, plural string) {
+	for _, in := range de.genders {
+		in.AddIrregularRule(single, plural)
+	}
+}
+
+// ToPluralGender makes a pluralized version of a noun of a given gender.
+func (de *German) ToPluralGender(word string, g Gender) string {
+	return de.Gender(g).ToPlural(word)
+}
+
+// ToSingularGender singularizes a noun of a given gender.
+func (de *German) ToSingularGender(word string, g Gender) string {
+	return de.Gender(g).ToSingular(word)
+}
+
+// ToPlural makes a pluralized version of a noun of unknown gender.
+func (de *German) ToPlural(word string) string {
+	return de.ToPluralGender(word, UnknownGender)
+}
+
+// ToSingular singularizes a noun of unknown gender.
+func (de *German) ToSingular(word string) string {
+	return de.ToSingularGender(word, UnknownGender)
+}
+
+// IsPlural returns true if a noun of unknown gender is plural.
+func (de *German) IsPlural(word string) bool {
+	return de.genders[UnknownGender].IsPlural(word)
+}
+
+// IsSingular returns true if a noun of unknown gender is singular.
+func (de *German) IsSingular(word string) bool {
+	return de.genders[UnknownGender].IsSingular(word)
+}
+
+func init() {
+	Register("de", NewGerman())
+}
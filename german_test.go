@@ -0,0 +1,147 @@
+package inflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var germanTests = [][]string{
+	// Umlauts.
+	{"Haus", "Häuser"},
+	{"Mann", "Männer"},
+	{"Buch", "Bücher"},
+	{"Stadt", "Städte"},
+	{"Fuß", "Füße"},
+	{"Vater", "Väter"},
+	{"Apfel", "Äpfel"},
+	{"Kind", "Kinder"},
+	{"Tag", "Tage"},
+	{"Hund", "Hunde"},
+	{"Jahr", "Jahre"},
+	{"Junge", "Jungen"},
+	{"Zahl", "Zahlen"},
+	{"Schwester", "Schwestern"},
+	{"Museum", "Museen"},
+	{"Firma", "Firmen"},
+	// Suffixes.
+	{"Zeitung", "Zeitungen"},
+	{"Freiheit", "Freiheiten"},
+	{"Möglichkeit", "Möglichkeiten"},
+	{"Mannschaft", "Mannschaften"},
+	{"Nation", "Nationen"},
+	{"Universität", "Universitäten"},
+	{"Bäckerei", "Bäckereien"},
+	{"Kultur", "Kulturen"},
+	{"Musik", "Musiken"},
+	{"Lehrerin", "Lehrerinnen"},
+	{"Freundin", "Freundinnen"},
+	{"Assistent", "Assistenten"},
+	{"Praktikant", "Praktikanten"},
+	{"Dokument", "Dokumente"},
+	{"Garage", "Garagen"},
+	{"Tabelle", "Tabellen"},
+	{"Maschine", "Maschinen"},
+	{"Rose", "Rosen"},
+	{"Stein", "Steine"},
+	{"Ergebnis", "Ergebnisse"},
+	{"Polizist", "Polizisten"},
+	{"Organismus", "Organismen"},
+	{"Studium", "Studien"},
+	{"Irrtum", "Irrtümer"},
+	{"Mädchen", "Mädchen"},
+	{"Fräulein", "Fräulein"},
+	{"Blume", "Blumen"},
+	{"Straße", "Straßen"},
+	{"Idee", "Ideen"},
+	{"Familie", "Familien"},
+	{"Frau", "Frauen"},
+	{"Lehrer", "Lehrer"},
+	{"Auto", "Autos"},
+	{"Hobby", "Hobbys"},
+	{"Taxi", "Taxis"},
+	{"Käse", "Käse"},
+	// Case.
+	{"haus", "häuser"},
+	{"HAUS", "HÄUSER"},
+	{"ZEITUNG", "ZEITUNGEN"},
+}
+
+// nouns that need a gender hint
+var germanGenderTests = []struct {
+	single, plural string
+	gender         Gender
+}{
+	{"Tag", "Tage", Masculine},
+	{"Hund", "Hunde", Masculine},
+	{"Berg", "Berge", Masculine},
+	{"Lehrer", "Lehrer", Masculine},
+	{"Wagen", "Wagen", Masculine},
+	{"Assistent", "Assistenten", Masculine},
+	{"Jahr", "Jahre", Neuter},
+	{"Tier", "Tiere", Neuter},
+	{"Fenster", "Fenster", Neuter},
+	{"Papier", "Papiere", Neuter},
+	{"Zeichen", "Zeichen", Neuter},
+	{"Dokument", "Dokumente", Neuter},
+	{"Freundin", "Freundinnen", Feminine},
+	{"Pflanze", "Pflanzen", Feminine},
+	{"Tabelle", "Tabellen", Feminine},
+	{"Frau", "Frauen", Feminine},
+	{"Oma", "Omas", Feminine},
+	// the dictionary is used for all genders
+	{"Haus", "Häuser", Neuter},
+	{"Hand", "Hände", Feminine},
+	{"Zeitung", "Zeitungen", Masculine},
+}
+
+func TestGerman(t *testing.T) {
+	testLanguage(t, NewGerman(), germanTests)
+}
+
+func TestGermanGender(t *testing.T) {
+	de := NewGerman()
+	for _, test := range germanGenderTests {
+		single, plural, g := test.single, test.plural, test.gender
+		assert.Equal(t, plural, de.ToPluralGender(single, g), "s: %s, g: %s", single, g)
+		assert.Equal(t, single, de.ToSingularGender(plural, g), "s: %s, g: %s", plural, g)
+		assert.Equal(t, single, de.ToSingularGender(single, g), "s: %s, g: %s", single, g)
+	}
+	// only a hint tells feminine words ending in a consonant apart
+	assert.Equal(t, "Schlachten", de.ToPluralGender("Schlacht", Feminine))
+	assert.Equal(t, "Tage", de.ToPluralGender("Tag", Gender(42)))
+}
+
+func TestGermanRules(t *testing.T) {
+	de := NewGerman()
+	de.AddIrregularRule("Pizza", "Pizzen")
+	assert.Equal(t, "Pizzen", de.ToPluralGender("Pizza", Feminine))
+	assert.Equal(t, "Pizza", de.ToSingular("Pizzen"))
+	assert.Equal(t, "Pizzas", NewGerman().ToPlural("Pizza"))
+	// the plural of "Band" depends on its gender and meaning
+	de.Gender(Masculine).AddIrregularRule("Band", "Bände")
+	de.Gender(Feminine).AddIrregularRule("Band", "Bands")
+	de.Gender(Neuter).AddIrregularRule("Band", "Bänder")
+	assert.Equal(t, "Bände", de.ToPluralGender("Band", Masculine))
+	assert.Equal(t, "Bands", de.ToPluralGender("Band", Feminine))
+	assert.Equal(t, "Bänder", de.ToPluralGender("Band", Neuter))
+	assert.Equal(t, "Band", de.ToSingularGender("Bänder", Neuter))
+	assert.Equal(t, "Flöße", de.ToPlural("Floß"))
+}
+
+func TestGermanRegistered(t *testing.T) {
+	assert.Equal(t, "Häuser", For("de").ToPlural("Haus"))
+	assert.Equal(t, "Frauen", For("de-AT").ToPlural("Frau"))
+	assert.Equal(t, "Stadt", For("de_CH").ToSingular("Städte"))
+	de, ok := Lookup("de")
+	assert.True(t, ok)
+	assert.Equal(t, "Freundinnen", de.(*German).ToPluralGender("Freundin", Feminine))
+}
+
+func TestGenderString(t *testing.T) {
+	assert.Equal(t, "masculine", Masculine.String())
+	assert.Equal(t, "feminine", Feminine.String())
+	assert.Equal(t, "neuter", Neuter.String())
+	assert.Equal(t, "unknown", UnknownGender.String())
+	assert.Equal(t, "unknown", Gender(-1).String())
+}
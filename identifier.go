@@ -0,0 +1,112 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// identifierWords returns byte spans of words in a CamelCase, snake_case,
+// kebab-case or SCREAMING_CASE identifier. "HTTPServer" is split into
+// "HTTP" and "Server". Plurals of acronyms are kept together, so
+// "UserIDs" is split into "User" and "IDs" if "ID" is in acronyms.
+func identifierWords(s string, acronyms map[string]string) [][2]int {
+	var res [][2]int
+	start := -1
+	var prev rune
+	for i, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				res = append(res, [2]int{start, i})
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+			prev = r
+			continue
+		}
+		split := false
+		if unicode.IsUpper(r) {
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) {
+				// "userAccount"
+				split = true
+			} else if unicode.IsUpper(prev) {
+				// "HTTPServer", but not "UserIDs"
+				end := i + utf8.RuneLen(r)
+				next, _ := utf8.DecodeRuneInString(s[end:])
+				split = unicode.IsLower(next) && !isAcronymPlural(s[start:end], s[end:], acronyms)
+			}
+		}
+		if split {
+			res = append(res, [2]int{start, i})
+			start = i
+		}
+		prev = r
+	}
+	if start >= 0 {
+		res = append(res, [2]int{start, len(s)})
+	}
+	return res
+}
+
+// isAcronymPlural returns true if word is an acronym followed by "s"
+// that ends a word in rest
+func isAcronymPlural(word, rest string, acronyms map[string]string) bool {
+	if len(rest) == 0 || rest[0] != 's' {
+		return false
+	}
+	if a, ok := acronyms[strings.ToLower(word)]; !ok || a != word {
+		return false
+	}
+	next, _ := utf8.DecodeRuneInString(rest[1:])
+	return !unicode.IsLower(next)
+}
+
+func (in *Inflector) inflectIdentifier(s string, plural bool) string {
+	words := identifierWords(s, in.load().acronyms)
+	if len(words) == 0 {
+		return s
+	}
+	last := words[len(words)-1]
+	word := s[last[0]:last[1]]
+	var res string
+	if plural {
+		res = in.ToPlural(word)
+	} else {
+		res = in.ToSingular(word)
+	}
+	if res == word {
+		return s
+	}
+	// "USER_ID" becomes "USER_IDS" rather than "USER_IDs"
+	if len(words) > 1 && isUpper(s) {
+		res = strings.ToUpper(res)
+	}
+	return s[:last[0]] + res + s[last[1]:]
+}
+
+// PluralizeIdentifier pluralizes the last word of a CamelCase, snake_case,
+// kebab-case or SCREAMING_CASE identifier, keeping the rest of it intact,
+// e.g. "UserAccount" becomes "UserAccounts" and "user_address" becomes
+// "user_addresses".
+func (in *Inflector) PluralizeIdentifier(s string) string {
+	return in.inflectIdentifier(s, true)
+}
+
+// SingularizeIdentifier singularizes the last word of an identifier, e.g.
+// "UserAccounts" becomes "UserAccount".
+func (in *Inflector) SingularizeIdentifier(s string) string {
+	return in.inflectIdentifier(s, false)
+}
+
+// PluralizeIdentifier pluralizes the last word of an identifier.
+func PluralizeIdentifier(s string) string {
+	return defaultInflector.PluralizeIdentifier(s)
+}
+
+// SingularizeIdentifier singularizes the last word of an identifier.
+func SingularizeIdentifier(s string) string {
+	return defaultInflector.SingularizeIdentifier(s)
+}
@@ -0,0 +1,60 @@
+package inflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var identifierTests = [][]string{
+	{"UserAccount", "UserAccounts"},
+	{"userAccount", "userAccounts"},
+	{"user_address", "user_addresses"},
+	{"USER_ADDRESS", "USER_ADDRESSES"},
+	{"user-address", "user-addresses"},
+	{"HTTPServer", "HTTPServers"},
+	{"BlogPost_", "BlogPosts_"},
+	{"_person", "_people"},
+	{"OrderItemCategory", "OrderItemCategories"},
+	{"ÉcoleBus", "ÉcoleBuses"},
+	{"UserID", "UserIDs"},
+	{"user_ID", "user_IDs"},
+	{"USER_ID", "USER_IDS"},
+	{"ProductSKU", "ProductSKUs"},
+	{"APIKey", "APIKeys"},
+	{"Person", "People"},
+	{"sheep", "sheep"},
+	{"", ""},
+}
+
+func TestPluralizeIdentifier(t *testing.T) {
+	for i, test := range identifierTests {
+		assert.Equal(t, test[1], PluralizeIdentifier(test[0]), "s: %s, i: %d", test[0], i)
+	}
+}
+
+func TestSingularizeIdentifier(t *testing.T) {
+	for i, test := range identifierTests {
+		assert.Equal(t, test[0], SingularizeIdentifier(test[1]), "s: %s, i: %d", test[1], i)
+	}
+}
+
+func TestIdentifierWords(t *testing.T) {
+	acronyms := New().load().acronyms
+	split := func(s string) []string {
+		var res []string
+		for _, w := range identifierWords(s, acronyms) {
+			res = append(res, s[w[0]:w[1]])
+		}
+		return res
+	}
+	assert.Equal(t, []string{"HTTP", "Server", "Error"}, split("HTTPServerError"))
+	assert.Equal(t, []string{"user", "Account", "Id"}, split("user__AccountId"))
+	assert.Equal(t, []string{"SCREAMING", "CASE"}, split("SCREAMING_CASE"))
+	assert.Equal(t, []string{"ipv4", "Address"}, split("ipv4Address"))
+	assert.Equal(t, []string{"User", "IDs"}, split("UserIDs"))
+	assert.Equal(t, []string{"APIs", "List"}, split("APIsList"))
+	assert.Equal(t, []string{"HTTPS", "Server"}, split("HTTPSServer"))
+	assert.Equal(t, []string{"FO", "Os"}, split("FOOs"))
+	assert.Nil(t, split("__"))
+}
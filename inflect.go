@@ -2,10 +2,9 @@ package inflect
 
 import (
 	"fmt"
-	"regexp"
-	"strconv"
 	"strings"
-	"unicode"
+	"sync"
+	"sync/atomic"
 	"unicode/utf8"
 )
 
@@ -218,159 +217,196 @@ var uncountableRules = []string{
 	`/sheep$/i`,
 }
 
-type rxRule struct {
-	// TODO: for debugging, maybe remove when working
-	rxStrJs string
-	rxStrGo string
+// ruleSet is a snapshot of the rules used by an Inflector. Once published
+// it's never modified: adding a rule creates an updated copy, so lookups
+// don't need locking.
+type ruleSet struct {
+	// Rule storage - pluralize and singularize need to be run sequentially,
+	// while other rules can be optimized using an object for instant lookups.
+	plural           ruleList
+	singular         ruleList
+	irregularPlurals map[string]string
+	irregularSingles map[string]string
+	uncountables     map[string]string
+	acronyms         map[string]string // lower case to canonical form, e.g. "url" to "URL"
+}
 
-	rx          *regexp.Regexp
-	replacement string
+// ruleList is a list of rules, where later rules take precedence,
+// along with a matcher that finds the rule to use without running regexps.
+type ruleList struct {
+	rules   []rxRule
+	matcher *suffixMatcher
 }
 
-// Rule storage - pluralize and singularize need to be run sequentially,
-// while other rules can be optimized using an object for instant lookups.
-var pluralRules []rxRule
-var singularRules []rxRule
-var irregularPlurals = map[string]string{}
-var irregularSingles = map[string]string{}
-var uncountables = map[string]string{}
+func (l *ruleList) add(r rxRule) {
+	l.rules = append(l.rules, r)
+	l.matcher = nil
+}
 
-func init() {
-	// order is important
-	addIrregularRules()
-	addPluralizationRules()
-	addSingularizationRules()
-	addUncountableRules()
+func (l *ruleList) clone() ruleList {
+	return ruleList{rules: append([]rxRule(nil), l.rules...)}
 }
 
-// Add a pluralization rule to the collection.
-func addPluralRule(rule string, replacement string) {
-	rx, rxStrGo := sanitizeRule(rule)
-	r := rxRule{
-		rxStrJs:     rule,
-		rxStrGo:     rxStrGo,
-		rx:          rx,
-		replacement: jsReplaceSyntaxToGo(replacement),
+func newRuleSet() *ruleSet {
+	return &ruleSet{
+		irregularPlurals: map[string]string{},
+		irregularSingles: map[string]string{},
+		uncountables:     map[string]string{},
+		acronyms:         map[string]string{},
 	}
-	pluralRules = append(pluralRules, r)
 }
 
-func panicIf(cond bool, format string, args ...interface{}) {
-	if !cond {
-		return
+func copyMap(m map[string]string) map[string]string {
+	res := make(map[string]string, len(m))
+	for k, v := range m {
+		res[k] = v
 	}
-	s := format
-	if len(args) > 0 {
-		s = fmt.Sprintf(format, args...)
+	return res
+}
+
+// clone returns a copy of rs that can be modified without affecting rs.
+func (rs *ruleSet) clone() *ruleSet {
+	return &ruleSet{
+		plural:           rs.plural.clone(),
+		singular:         rs.singular.clone(),
+		irregularPlurals: copyMap(rs.irregularPlurals),
+		irregularSingles: copyMap(rs.irregularSingles),
+		uncountables:     copyMap(rs.uncountables),
+		acronyms:         copyMap(rs.acronyms),
 	}
-	panic(s)
 }
 
-var (
-	unicodeSyntaxRx = regexp.MustCompile(`\\u([[:xdigit:]]{4})`)
-)
+// compile builds matchers for the rules. It must be called before
+// the rule set is used.
+func (rs *ruleSet) compile() {
+	rs.plural.matcher = newSuffixMatcher(rs.plural.rules)
+	rs.singular.matcher = newSuffixMatcher(rs.singular.rules)
+}
 
-// best-effort of converting javascript regex syntax to equivalent go syntax
-func jsRxSyntaxToGo(rx string) string {
-	s := rx
-	caseInsensitive := false
-	panicIf(s[0] != '/', "expected '%s' to start with '/'", rx)
-	s = s[1:]
-	n := len(s)
-	if s[n-1] == 'i' {
-		n--
-		caseInsensitive = true
-		s = s[:n]
-	}
-	panicIf(s[n-1] != '/', "expected '%s' to end with '/'", rx)
-	s = s[:n-1]
-	// \uNNNN syntax for unicode code points to \x{NNNN} syntax for hex character code
-	s = unicodeSyntaxRx.ReplaceAllString(s, "\\x{$1}")
-	if caseInsensitive {
-		s = "(?i)" + s
-	}
-	return s
-}
-
-func jsReplaceSyntaxToGo(s string) string {
-	s = strings.Replace(s, "$0", "${0}", -1)
-	s = strings.Replace(s, "$1", "${1}", -1)
-	s = strings.Replace(s, "$2", "${2}", -1)
-	return s
-}
-
-// Sanitize a pluralization rule to a usable regular expression.
-func sanitizeRule(rule string) (*regexp.Regexp, string) {
-	// in JavaScript, regexpes start with /
-	// others are just regular strings
-	var s string
-	if rule[0] != '/' {
-		// a plain string match is converted to regexp that:
-		// ^ ... $ : does exact match (matches at the beginning and end)
-		// (?i) : is case-insensitive
-		s = `(?i)^` + rule + `$`
-	} else {
-		s = jsRxSyntaxToGo(rule)
-	}
-	return regexp.MustCompile(s), s
-}
-
-// Add a singularization rule to the collection.
-func addSingularRule(rule, replacement string) {
-	rx, rxGo := sanitizeRule(rule)
-	r := rxRule{
-		rxStrJs:     rule,
-		rxStrGo:     rxGo,
-		rx:          rx,
-		replacement: jsReplaceSyntaxToGo(replacement),
-	}
-	singularRules = append(singularRules, r)
-}
-
-// copied from strings.ToUpper
-// returns true if s is uppercase
-func isUpper(s string) bool {
-	isASCII, hasLower := true, false
-	for i := 0; i < len(s); i++ {
-		c := s[i]
-		if c >= utf8.RuneSelf {
-			isASCII = false
-			break
-		}
-		hasLower = hasLower || (c >= 'a' && c <= 'z')
+// Inflector pluralizes and singularizes words using its own set of rules.
+// Different Inflectors don't share rules, so they can be customized
+// independently. Create one with New. The zero value is an Inflector
+// without any rules.
+//
+// It's safe to use an Inflector from multiple goroutines, including adding
+// rules while other goroutines inflect words.
+type Inflector struct {
+	tables *ruleTables // built-in rules, compiled on first use
+	once   sync.Once
+
+	mu    sync.Mutex   // serializes rule updates
+	rules atomic.Value // *ruleSet, replaced on every update
+
+	cache atomic.Value // *lruCache, nil if not enabled
+}
+
+// ruleTables are the built-in rules an Inflector starts with.
+type ruleTables struct {
+	irregular   [][]string
+	plural      [][]string
+	singular    [][]string
+	uncountable []string
+	acronyms    []string
+}
+
+var englishTables = &ruleTables{
+	irregular:   irregularRules,
+	plural:      pluralizationRules,
+	singular:    singularizationRules,
+	uncountable: uncountableRules,
+	acronyms:    acronymRules,
+}
+
+// package-level functions use this instance
+var defaultInflector = New()
+
+// New returns an Inflector initialized with the default English rules.
+// The rules are compiled when the Inflector is first used, so creating
+// one is cheap.
+func New() *Inflector {
+	return newInflector(englishTables)
+}
+
+func newInflector(tables *ruleTables) *Inflector {
+	return &Inflector{tables: tables}
+}
+
+// setup compiles the built-in rules
+func (in *Inflector) setup() {
+	rs := newRuleSet()
+	if in.tables != nil {
+		rs.addTables(in.tables)
 	}
-	if isASCII {
-		return !hasLower
+	rs.compile()
+	in.rules.Store(rs)
+}
+
+// load returns the current snapshot of rules.
+func (in *Inflector) load() *ruleSet {
+	in.once.Do(in.setup)
+	return in.rules.Load().(*ruleSet)
+}
+
+// update applies fn to a copy of the current rules and publishes the result.
+// If fn fails, the rules are left unchanged.
+func (in *Inflector) update(fn func(rs *ruleSet) error) error {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	rs := in.load().clone()
+	if err := fn(rs); err != nil {
+		return err
 	}
-	for r := range s {
-		if !unicode.IsUpper(rune(r)) {
-			return false
-		}
+	rs.compile()
+	in.rules.Store(rs)
+	if c := in.loadCache(); c != nil {
+		c.purge()
 	}
-	return true
+	return nil
 }
 
-// Pass in a word token to produce a function that can replicate the case on
-// another word.
-func restoreCase(word string, token string) string {
-	// Tokens are an exact match.
-	if word == token {
-		return token
-	}
+// AddRule adds a rule of a given kind. Unlike AddPluralRule and friends
+// it doesn't panic on invalid rules but returns a *RuleError describing
+// the problem, which is useful when rules come from configuration.
+//
+// For IrregularRule, rule is the singular and replacement the plural form.
+// For UncountableRule, replacement is ignored.
+func (in *Inflector) AddRule(kind RuleKind, rule, replacement string) error {
+	return in.update(func(rs *ruleSet) error {
+		return rs.addRule(kind, rule, replacement)
+	})
+}
 
-	// Upper cased words. E.g. "HELLO".
-	if isUpper(word) {
-		return strings.ToUpper(token)
+func (in *Inflector) mustAddRule(kind RuleKind, rule, replacement string) {
+	if err := in.AddRule(kind, rule, replacement); err != nil {
+		panic(err)
 	}
+}
 
-	// Title cased words. E.g. "Title".
-	prefix := word[:1]
-	if isUpper(prefix) {
-		return strings.ToUpper(token[:1]) + strings.ToLower(token[1:])
-	}
+// AddPluralRule adds a pluralization rule. The rule is either a plain word,
+// matched case-insensitively against the whole word, or a JavaScript-style
+// regular expression like `/(matr)ix$/i`. Replacement can refer to
+// matched groups with $1, $2 etc. Rules added later take precedence.
+// It panics if the rule is not a valid regular expression.
+func (in *Inflector) AddPluralRule(rule string, replacement string) {
+	in.mustAddRule(PluralRule, rule, replacement)
+}
 
-	// Lower cased words. E.g. "test".
-	return strings.ToLower(token)
+// AddSingularRule adds a singularization rule. The syntax of rule and
+// replacement is the same as in AddPluralRule.
+func (in *Inflector) AddSingularRule(rule, replacement string) {
+	in.mustAddRule(SingularRule, rule, replacement)
+}
+
+// AddIrregularRule adds an irregular word whose singular and plural forms
+// don't follow the rules, e.g. "person" and "people".
+func (in *Inflector) AddIrregularRule(single, plural string) {
+	in.mustAddRule(IrregularRule, single, plural)
+}
+
+// AddUncountableRule adds a word that has the same singular and plural form,
+// e.g. "sheep". It can also be a regular expression like `/fish$/i`.
+func (in *Inflector) AddUncountableRule(word string) {
+	in.mustAddRule(UncountableRule, word, "")
 }
 
 // Replace a word using a rule.
@@ -383,14 +419,72 @@ func replace(word string, rule rxRule) string {
 	return rule.rx.ReplaceAllString(word, repl)
 }
 
+// appendLower appends word in lower case to dst.
+func appendLower(dst []byte, word string) []byte {
+	for i := 0; i < len(word); i++ {
+		if word[i] >= utf8.RuneSelf {
+			return append(dst, strings.ToLower(word)...)
+		}
+	}
+	for i := 0; i < len(word); i++ {
+		c := word[i]
+		if c >= 'A' && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+	return dst
+}
+
 // Sanitize a word by passing in the word and sanitization rules.
-func sanitizeWord(token string, word string, rules []rxRule) string {
+// If ex is not nil, it records which rule was used.
+func (rs *ruleSet) sanitizeWord(token string, word string, list *ruleList, ex *Inflection) string {
+	var buf [64]byte
+	res := rs.appendSanitized(buf[:0], []byte(token), word, list, ex)
+	if string(res) == word {
+		return word
+	}
+	return string(res)
+}
+
+// appendSanitized appends word, changed by the matching rule, to dst.
+func (rs *ruleSet) appendSanitized(dst []byte, token []byte, word string, list *ruleList, ex *Inflection) []byte {
 	// Empty string or doesn't need fixing.
 	if len(token) == 0 {
-		return word
+		return append(dst, word...)
 	}
-	if _, ok := uncountables[token]; ok {
-		return word
+	if _, ok := rs.uncountables[string(token)]; ok {
+		if ex != nil {
+			ex.Source = SourceUncountable
+		}
+		return append(dst, word...)
+	}
+
+	rules := list.rules
+	if m := list.matcher; m != nil && utf8.ValidString(word) {
+		var res suffixMatch
+		best := -1
+		if m.find(word, &res) {
+			best = res.acc.rule
+		}
+		// rules that are not suffix patterns still need regexps, but only
+		// if they would take precedence over the suffix match
+		for i := len(m.fallback) - 1; i >= 0 && m.fallback[i] > best; i-- {
+			rule := rules[m.fallback[i]]
+			if rule.rx.MatchString(word) {
+				if ex != nil {
+					ex.explainRule(m.fallback[i], rule)
+				}
+				return append(dst, replace(word, rule)...)
+			}
+		}
+		if best < 0 {
+			return append(dst, word...)
+		}
+		if ex != nil {
+			ex.explainRule(best, rules[best])
+		}
+		return res.appendReplacement(dst, word, isUpper(word))
 	}
 
 	// Iterate over the sanitization rules and use the first one to match.
@@ -399,33 +493,80 @@ func sanitizeWord(token string, word string, rules []rxRule) string {
 	for i := n - 1; i >= 0; i-- {
 		rule := rules[i]
 		if rule.rx.MatchString(word) {
-			return replace(word, rule)
+			if ex != nil {
+				ex.explainRule(i, rule)
+			}
+			return append(dst, replace(word, rule)...)
 		}
 	}
-	return word
+	return append(dst, word...)
 }
 
 // Replace a word with the updated word.
-func replaceWord(word string, replaceMap map[string]string, keepMap map[string]string, rules []rxRule) string {
+// If ex is not nil, it records how the word was replaced.
+func (rs *ruleSet) replaceWord(word string, replaceMap map[string]string, keepMap map[string]string, rules *ruleList, ex *Inflection) string {
+	var buf [64]byte
+	res := rs.appendWord(buf[:0], word, replaceMap, keepMap, rules, ex)
+	if string(res) == word {
+		return word
+	}
+	return string(res)
+}
+
+// appendWord appends the updated word to dst. It doesn't allocate
+// when the word is changed by a suffix rule.
+func (rs *ruleSet) appendWord(dst []byte, word string, replaceMap map[string]string, keepMap map[string]string, rules *ruleList, ex *Inflection) []byte {
 	// Get the correct token and case restoration functions.
-	token := strings.ToLower(word)
+	var buf [64]byte
+	token := appendLower(buf[:0], word)
 
 	// Check against the keep object map.
-	if _, ok := keepMap[token]; ok {
-		return restoreCase(word, token)
+	if _, ok := keepMap[string(token)]; ok {
+		if ex != nil {
+			ex.Source = SourceIrregular
+		}
+		return append(dst, restoreCase(word, string(token))...)
 	}
 
 	// Check against the replacement map for a direct word replacement.
-	if s, ok := replaceMap[token]; ok {
-		return restoreCase(word, s)
+	if s, ok := replaceMap[string(token)]; ok {
+		if ex != nil {
+			ex.Source = SourceIrregular
+		}
+		return append(dst, restoreCase(word, s)...)
 	}
 
 	// Run all the rules against the word.
-	return sanitizeWord(token, word, rules)
+	return rs.appendSanitized(dst, token, word, rules, ex)
+}
+
+// inflectWord returns the plural or singular form of word.
+// If ex is not nil, it records how the word was inflected.
+func (rs *ruleSet) inflectWord(word string, plural bool, ex *Inflection) string {
+	var buf [64]byte
+	res := rs.appendInflected(buf[:0], word, plural, ex)
+	if string(res) == word {
+		return word
+	}
+	return string(res)
+}
+
+// appendInflected appends the plural or singular form of word to dst.
+func (rs *ruleSet) appendInflected(dst []byte, word string, plural bool, ex *Inflection) []byte {
+	if res, ok := rs.appendAcronym(dst, word, plural); ok {
+		if ex != nil {
+			ex.Source = SourceAcronym
+		}
+		return res
+	}
+	if plural {
+		return rs.appendWord(dst, word, rs.irregularSingles, rs.irregularPlurals, &rs.plural, ex)
+	}
+	return rs.appendWord(dst, word, rs.irregularPlurals, rs.irregularSingles, &rs.singular, ex)
 }
 
 // Check if a word is part of the map.
-func checkWord(word string, replaceMap map[string]string, keepMap map[string]string, rules []rxRule) bool {
+func (rs *ruleSet) checkWord(word string, replaceMap map[string]string, keepMap map[string]string, rules *ruleList) bool {
 	token := strings.ToLower(word)
 
 	if _, ok := keepMap[token]; ok {
@@ -436,76 +577,215 @@ func checkWord(word string, replaceMap map[string]string, keepMap map[string]str
 		return false
 	}
 
-	return sanitizeWord(token, token, rules) == token
+	return rs.sanitizeWord(token, token, rules, nil) == token
 }
 
-// Add an irregular word definition.
-func addIrregularRules() {
-	for _, rule := range irregularRules {
-		single := strings.ToLower(rule[0])
-		plural := strings.ToLower(rule[1])
+func (rs *ruleSet) addRule(kind RuleKind, rule, replacement string) error {
+	switch kind {
+	case PluralRule, SingularRule:
+		r, err := newRxRule(rule, replacement)
+		if err != nil {
+			return err
+		}
+		if kind == PluralRule {
+			rs.plural.add(r)
+		} else {
+			rs.singular.add(r)
+		}
+	case IrregularRule:
+		if rule == "" || replacement == "" {
+			return &RuleError{Rule: rule, Pos: -1, Reason: "irregular rule needs both singular and plural form"}
+		}
+		// Add an irregular word definition.
+		single := strings.ToLower(rule)
+		plural := strings.ToLower(replacement)
+		rs.irregularSingles[single] = plural
+		rs.irregularPlurals[plural] = single
+	case UncountableRule:
+		if rule == "" {
+			return &RuleError{Rule: rule, Pos: -1, Reason: "empty rule"}
+		}
+		if rule[0] != '/' {
+			word := strings.ToLower(rule)
+			rs.uncountables[word] = word
+			return nil
+		}
+		// Set singular and plural references for the word.
+		r, err := newRxRule(rule, "$0")
+		if err != nil {
+			return err
+		}
+		rs.plural.add(r)
+		rs.singular.add(r)
+	case AcronymRule:
+		if rule == "" {
+			return &RuleError{Rule: rule, Pos: -1, Reason: "empty rule"}
+		}
+		rs.acronyms[strings.ToLower(rule)] = rule
+	default:
+		return &RuleError{Rule: rule, Pos: -1, Reason: fmt.Sprintf("unknown rule kind %d", int(kind))}
+	}
+	return nil
+}
 
-		irregularSingles[single] = plural
-		irregularPlurals[plural] = single
+// built-in rules are known to be valid
+func (rs *ruleSet) mustAddRule(kind RuleKind, rule, replacement string) {
+	if err := rs.addRule(kind, rule, replacement); err != nil {
+		panic(err)
 	}
 }
 
-func addSingularizationRules() {
-	for _, r := range singularizationRules {
-		addSingularRule(r[0], r[1])
+func (rs *ruleSet) addTables(t *ruleTables) {
+	// order is important
+	for _, rule := range t.irregular {
+		rs.mustAddRule(IrregularRule, rule[0], rule[1])
+	}
+	for _, rule := range t.plural {
+		rs.mustAddRule(PluralRule, rule[0], rule[1])
+	}
+	for _, r := range t.singular {
+		rs.mustAddRule(SingularRule, r[0], r[1])
+	}
+	for _, word := range t.uncountable {
+		rs.mustAddRule(UncountableRule, word, "")
 	}
+	for _, word := range t.acronyms {
+		rs.mustAddRule(AcronymRule, word, "")
+	}
+}
+
+// Pluralize or singularize a word based on the passed in count. Use
+// PluralizeWith to write the count in other ways, e.g. "an apple" or
+// "three cats".
+func (in *Inflector) Pluralize(word string, count int, inclusive bool) string {
+	return in.pluralize(word, int64(count), "", inclusive)
 }
 
-func addUncountableRules() {
-	for _, word := range uncountableRules {
-		if word[0] != '/' {
-			word = strings.ToLower(word)
-			uncountables[word] = word
-			continue
+// pluralize is Pluralize with count written as digits, if not empty
+func (in *Inflector) pluralize(word string, count int64, digits string, inclusive bool) string {
+	if !inclusive {
+		if count == 1 {
+			return in.ToSingular(word)
 		}
-		// Set singular and plural references for the word.
-		addPluralRule(word, "$0")
-		addSingularRule(word, "$0")
+		return in.ToPlural(word)
 	}
+	return in.pluralizeWith(word, count, digits, PluralizeOptions{})
 }
 
-func addPluralizationRules() {
-	for _, rule := range pluralizationRules {
-		addPluralRule(rule[0], rule[1])
-	}
+// IsPlural retruns true if word is plural
+func (in *Inflector) IsPlural(word string) bool {
+	rs := in.load()
+	return rs.checkWord(word, rs.irregularSingles, rs.irregularPlurals, &rs.plural)
 }
 
-// Pluralize or singularize a word based on the passed in count.
-func Pluralize(word string, count int, inclusive bool) string {
-	var res string
-	if count == 1 {
-		res = ToSingular(word)
-	} else {
-		res = ToPlural(word)
-	}
+// ToSingular singularizes a word.
+func (in *Inflector) ToSingular(word string) string {
+	return in.inflect(word, false)
+}
+
+// IsSingular returns true if a word is singular
+func (in *Inflector) IsSingular(word string) bool {
+	rs := in.load()
+	return rs.checkWord(word, rs.irregularPlurals, rs.irregularSingles, &rs.singular)
+}
+
+// ToPlural makes a pluralized version of a word
+func (in *Inflector) ToPlural(word string) string {
+	return in.inflect(word, true)
+}
 
-	if inclusive {
-		return strconv.Itoa(count) + " " + res
+// inflect pluralizes or singularizes a word, using the cache if enabled
+func (in *Inflector) inflect(word string, plural bool) string {
+	rs := in.load()
+	c := in.loadCache()
+	if c != nil {
+		if s, ok := c.get(rs, plural, word); ok {
+			return s
+		}
+	}
+	res := rs.inflectWord(word, plural, nil)
+	if c != nil {
+		c.add(rs, plural, word, res)
 	}
 	return res
 }
 
+// AppendPlural appends the plural form of word to dst and returns
+// the extended buffer. Unlike ToPlural, it doesn't allocate for words
+// pluralized by regular suffix rules, if dst has enough capacity.
+func (in *Inflector) AppendPlural(dst []byte, word string) []byte {
+	rs := in.load()
+	return rs.appendInflected(dst, word, true, nil)
+}
+
+// AppendSingular appends the singular form of word to dst and returns
+// the extended buffer. See AppendPlural.
+func (in *Inflector) AppendSingular(dst []byte, word string) []byte {
+	rs := in.load()
+	return rs.appendInflected(dst, word, false, nil)
+}
+
+// Pluralize or singularize a word based on the passed in count, which can
+// be of any integer type. Use PluralizeWith to write the count in other
+// ways.
+func Pluralize[T Integer](word string, count T, inclusive bool) string {
+	return defaultInflector.pluralize(word, toInt64(count), bigDigits(count), inclusive)
+}
+
 // IsPlural retruns true if word is plural
 func IsPlural(word string) bool {
-	return checkWord(word, irregularSingles, irregularPlurals, pluralRules)
+	return defaultInflector.IsPlural(word)
 }
 
 // ToSingular singularizes a word.
 func ToSingular(word string) string {
-	return replaceWord(word, irregularPlurals, irregularSingles, singularRules)
+	return defaultInflector.ToSingular(word)
 }
 
 // IsSingular returns true if a word is singular
 func IsSingular(word string) bool {
-	return checkWord(word, irregularPlurals, irregularSingles, singularRules)
+	return defaultInflector.IsSingular(word)
 }
 
 // ToPlural makes a pluralized version of a word
 func ToPlural(word string) string {
-	return replaceWord(word, irregularSingles, irregularPlurals, pluralRules)
+	return defaultInflector.ToPlural(word)
+}
+
+// AddRule adds a rule of a given kind to the default rule set, returning
+// an error if the rule is invalid.
+func AddRule(kind RuleKind, rule, replacement string) error {
+	return defaultInflector.AddRule(kind, rule, replacement)
+}
+
+// AddPluralRule adds a pluralization rule to the default rule set.
+func AddPluralRule(rule string, replacement string) {
+	defaultInflector.AddPluralRule(rule, replacement)
+}
+
+// AddSingularRule adds a singularization rule to the default rule set.
+func AddSingularRule(rule string, replacement string) {
+	defaultInflector.AddSingularRule(rule, replacement)
+}
+
+// AddIrregularRule adds an irregular word to the default rule set.
+func AddIrregularRule(single, plural string) {
+	defaultInflector.AddIrregularRule(single, plural)
+}
+
+// AddUncountableRule adds an uncountable word to the default rule set.
+func AddUncountableRule(word string) {
+	defaultInflector.AddUncountableRule(word)
+}
+
+// AppendPlural appends the plural form of word to dst and returns
+// the extended buffer.
+func AppendPlural(dst []byte, word string) []byte {
+	return defaultInflector.AppendPlural(dst, word)
+}
+
+// AppendSingular appends the singular form of word to dst and returns
+// the extended buffer.
+func AppendSingular(dst []byte, word string) []byte {
+	return defaultInflector.AppendSingular(dst, word)
 }
@@ -722,56 +722,147 @@ func TestPrependCount(t *testing.T) {
 	}
 }
 
-/*
-describe("pluralize", function () {
-  describe("adding new rules", function () {
-    it("uncountable rules", function () {
-      expect(pluralize("paper")).to.equal("papers");
+func TestInflectorInstance(t *testing.T) {
+	in := New()
+	assert.True(t, in != defaultInflector)
+	for i, test := range allPluralTests {
+		s := test[0]
+		assert.Equal(t, ToPlural(s), in.ToPlural(s), "s: %s, i: %d", s, i)
+		assert.Equal(t, IsPlural(test[1]), in.IsPlural(test[1]), "s: %s, i: %d", test[1], i)
+	}
+	for i, test := range allSingularTests {
+		s := test[1]
+		assert.Equal(t, ToSingular(s), in.ToSingular(s), "s: %s, i: %d", s, i)
+		assert.Equal(t, IsSingular(test[0]), in.IsSingular(test[0]), "s: %s, i: %d", test[0], i)
+	}
+	assert.Equal(t, "5 tests", in.Pluralize("test", 5, true))
+}
 
-      pluralize.addUncountableRule("paper");
+func TestAddUncountableRule(t *testing.T) {
+	in := New()
+	assert.Equal(t, "papers", in.ToPlural("paper"))
+	in.AddUncountableRule("paper")
+	assert.Equal(t, "paper", in.ToPlural("paper"))
+	assert.Equal(t, "papers", ToPlural("paper"))
+
+	assert.Equal(t, "TOFUS", in.ToPlural("TOFU"))
+	in.AddUncountableRule(`/tofu$/i`)
+	assert.Equal(t, "TOFU", in.ToPlural("TOFU"))
+	assert.Equal(t, "TOFU", in.ToSingular("TOFU"))
+}
 
-      expect(pluralize("paper")).to.equal("paper");
-    });
+func TestAddIrregularRule(t *testing.T) {
+	in := New()
+	assert.Equal(t, "irregulars", in.ToPlural("irregular"))
+	in.AddIrregularRule("irregular", "regular")
+	assert.Equal(t, "regular", in.ToPlural("irregular"))
+	assert.Equal(t, "irregular", in.ToSingular("regular"))
 
-    it("should allow new irregular words", function () {
-      expect(pluralize("irregular")).to.equal("irregulars");
+	in.AddIrregularRule("schema", "schemas")
+	assert.Equal(t, "schemas", in.ToPlural("schema"))
+	assert.Equal(t, "Schema", in.ToSingular("Schemas"))
+}
 
-      pluralize.addIrregularRule("irregular", "regular");
+func TestAddPluralRule(t *testing.T) {
+	in := New()
+	assert.Equal(t, "regexes", in.ToPlural("regex"))
+	in.AddPluralRule(`/gex$/i`, "gexii")
+	assert.Equal(t, "regexii", in.ToPlural("regex"))
 
-      expect(pluralize("irregular")).to.equal("regular");
-    });
+	assert.Equal(t, "people", in.ToPlural("person"))
+	in.AddPluralRule("person", "peeps")
+	assert.Equal(t, "peeps", in.ToPlural("person"))
+}
 
-    it("should allow new plural matching rules", function () {
-      expect(pluralize.plural("regex")).to.equal("regexes");
+func TestAddSingularRule(t *testing.T) {
+	in := New()
+	assert.Equal(t, "single", in.ToSingular("singles"))
+	in.AddSingularRule(`/singles$/`, "singular")
+	assert.Equal(t, "singular", in.ToSingular("singles"))
 
-      pluralize.addPluralRule(/gex$/i, "gexii");
+	assert.Equal(t, "morning", in.ToSingular("mornings"))
+	in.AddSingularRule("mornings", "suck")
+	assert.Equal(t, "suck", in.ToSingular("mornings"))
+}
 
-      expect(pluralize.plural("regex")).to.equal("regexii");
-    });
+func TestAddRulePanics(t *testing.T) {
+	in := New()
+	assert.Panics(t, func() { in.AddPluralRule(`/(foo$/i`, "bar") })
+}
 
-    it("should allow new singular matching rules", function () {
-      expect(pluralize.singular("singles")).to.equal("single");
+func TestNewIsLazy(t *testing.T) {
+	in := New()
+	assert.Nil(t, in.rules.Load(), "rules should be compiled on first use")
+	assert.Equal(t, "men", in.ToPlural("man"))
+	assert.NotNil(t, in.rules.Load())
 
-      pluralize.addSingularRule(/singles$/, "singular");
+	in = New()
+	in.AddIrregularRule("schema", "schemas")
+	assert.Equal(t, "schemas", in.ToPlural("schema"))
+	assert.Equal(t, "men", in.ToPlural("man"))
+}
 
-      expect(pluralize.singular("singles")).to.equal("singular");
-    });
+func TestZeroInflector(t *testing.T) {
+	var in Inflector
+	assert.Equal(t, "man", in.ToPlural("man"))
+	in.AddPluralRule(`/$/`, "s")
+	assert.Equal(t, "mans", in.ToPlural("man"))
+}
 
-    it("should allow new plural matching rules to be strings", function () {
-      expect(pluralize.plural("person")).to.equal("people");
+// creating an Inflector, which is what happens at import time,
+// doesn't compile the rules
+var benchInflector *Inflector
 
-      pluralize.addPluralRule("person", "peeps");
+func BenchmarkNew(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchInflector = New()
+	}
+}
 
-      expect(pluralize.plural("person")).to.equal("peeps");
-    });
+func BenchmarkNewAndFirstUse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		New().ToPlural("man")
+	}
+}
 
-    it("should allow new singular matching rules to be strings", function () {
-      expect(pluralize.singular("mornings")).to.equal("morning");
+func TestAppendPlural(t *testing.T) {
+	buf := []byte("x: ")
+	for i, test := range allPluralTests {
+		got := AppendPlural(buf, test[0])
+		assert.Equal(t, "x: "+ToPlural(test[0]), string(got), "s: %s, i: %d", test[0], i)
+	}
+	for i, test := range allSingularTests {
+		got := AppendSingular(buf, test[1])
+		assert.Equal(t, "x: "+ToSingular(test[1]), string(got), "s: %s, i: %d", test[1], i)
+	}
+}
 
-      pluralize.addSingularRule("mornings", "suck");
+func TestAppendAllocs(t *testing.T) {
+	// make sure rules are compiled
+	ToPlural("")
+	buf := make([]byte, 0, 64)
+	words := []string{"user", "User", "USER", "category", "address", "box", "knife", "matrix"}
+	for _, word := range words {
+		plural := ToPlural(word)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = AppendPlural(buf[:0], word)
+		})
+		assert.Equal(t, 0.0, allocs, "word: %s", word)
+		allocs = testing.AllocsPerRun(100, func() {
+			buf = AppendSingular(buf[:0], plural)
+		})
+		assert.Equal(t, 0.0, allocs, "word: %s", plural)
+	}
+}
 
-      expect(pluralize.singular("mornings")).to.equal("suck");
-    });
-  });
-});
-*/
+func BenchmarkAppendPlural(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, w := range benchWords {
+			buf = AppendPlural(buf[:0], w)
+		}
+	}
+}
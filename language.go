@@ -0,0 +1,82 @@
+package inflect
+
+import (
+	"strings"
+	"sync"
+)
+
+// Language pluralizes and singularizes nouns of one language. *Inflector
+// implements it.
+type Language interface {
+	ToPlural(word string) string
+	ToSingular(word string) string
+	IsPlural(word string) bool
+	IsSingular(word string) bool
+}
+
+var _ Language = (*Inflector)(nil)
+
+var (
+	languagesMu sync.RWMutex
+	languages   = map[string]Language{
+		"en": defaultInflector,
+	}
+)
+
+// normalizeTag returns a BCP 47 tag like "pt_BR" as "pt-br"
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+}
+
+// parentTag returns the tag without its last subtag, e.g. "zh-hant" for
+// "zh-hant-tw", or false for a tag without subtags
+func parentTag(tag string) (string, bool) {
+	i := strings.LastIndexByte(tag, '-')
+	if i < 0 {
+		return "", false
+	}
+	return tag[:i], true
+}
+
+// Register makes a language available under a BCP 47 tag like "es" or
+// "pt-BR". It replaces a language registered under the same tag, so it
+// can also be used to customize English. It panics if tag is empty or
+// lang is nil.
+func Register(tag string, lang Language) {
+	if tag == "" {
+		panic("inflect: Register with empty tag")
+	}
+	if lang == nil {
+		panic("inflect: Register of nil language for " + tag)
+	}
+	languagesMu.Lock()
+	defer languagesMu.Unlock()
+	languages[normalizeTag(tag)] = lang
+}
+
+// Lookup returns the language registered for a BCP 47 tag. If there's no
+// language for the full tag, it tries the tag with subtags removed, e.g.
+// "es-419" and then "es" for "es-419".
+func Lookup(tag string) (Language, bool) {
+	languagesMu.RLock()
+	defer languagesMu.RUnlock()
+	tag = normalizeTag(tag)
+	for {
+		if lang, ok := languages[tag]; ok {
+			return lang, true
+		}
+		var ok bool
+		if tag, ok = parentTag(tag); !ok {
+			return nil, false
+		}
+	}
+}
+
+// For returns the language registered for a BCP 47 tag like Lookup does,
+// and English if there's none.
+func For(tag string) Language {
+	if lang, ok := Lookup(tag); ok {
+		return lang
+	}
+	return defaultInflector
+}
@@ -0,0 +1,77 @@
+package inflect
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testLanguage checks that lang converts between singular and plural forms
+// of words in tests both ways, and keeps words that are already converted
+func testLanguage(t *testing.T, lang Language, tests [][]string) {
+	for i, test := range tests {
+		single, plural := test[0], test[1]
+		assert.Equal(t, plural, lang.ToPlural(single), "s: %s, i: %d", single, i)
+		assert.Equal(t, single, lang.ToSingular(plural), "s: %s, i: %d", plural, i)
+		// Make sure the word stays pluralized or singular.
+		assert.Equal(t, plural, lang.ToPlural(plural), "s: %s, i: %d", plural, i)
+		assert.Equal(t, single, lang.ToSingular(single), "s: %s, i: %d", single, i)
+		assert.True(t, lang.IsPlural(plural), "s: %s, i: %d", plural, i)
+		assert.True(t, lang.IsSingular(single), "s: %s, i: %d", single, i)
+	}
+}
+
+// upperLanguage is a toy language for testing the registry
+type upperLanguage struct{}
+
+func (upperLanguage) ToPlural(word string) string   { return strings.ToUpper(word) }
+func (upperLanguage) ToSingular(word string) string { return strings.ToLower(word) }
+func (upperLanguage) IsPlural(word string) bool     { return isUpper(word) }
+func (upperLanguage) IsSingular(word string) bool   { return isLower(word) }
+
+func TestLanguageRegistry(t *testing.T) {
+	assert.Equal(t, "people", For("en").ToPlural("person"))
+	assert.Equal(t, "people", For("en-US").ToPlural("person"))
+	assert.Equal(t, "people", For("en_GB").ToPlural("person"))
+	assert.True(t, For("EN").IsPlural("people"))
+
+	_, ok := Lookup("x-test")
+	assert.False(t, ok)
+	// unknown languages fall back to English
+	assert.Equal(t, "cats", For("x-test").ToPlural("cat"))
+
+	Register("x-test", upperLanguage{})
+	defer func() {
+		languagesMu.Lock()
+		delete(languages, "x-test")
+		languagesMu.Unlock()
+	}()
+	lang, ok := Lookup("x-test")
+	assert.True(t, ok)
+	assert.Equal(t, "CAT", lang.ToPlural("cat"))
+	assert.Equal(t, "CAT", For("X-Test-Region").ToPlural("cat"))
+	assert.Equal(t, "cat", For("x_test").ToSingular("CAT"))
+
+	en, ok := Lookup("en")
+	assert.True(t, ok)
+	assert.True(t, en == Language(defaultInflector))
+}
+
+func TestRegisterPanics(t *testing.T) {
+	assert.Panics(t, func() { Register("", upperLanguage{}) })
+	assert.Panics(t, func() { Register("x-nil", nil) })
+}
+
+func TestRegisterInflector(t *testing.T) {
+	in := New()
+	in.AddIrregularRule("octopus", "octopodes")
+	Register("en-x-pedantic", in)
+	defer func() {
+		languagesMu.Lock()
+		delete(languages, "en-x-pedantic")
+		languagesMu.Unlock()
+	}()
+	assert.Equal(t, "octopodes", For("en-x-pedantic").ToPlural("octopus"))
+	assert.Equal(t, "octopi", For("en").ToPlural("octopus"))
+}
@@ -0,0 +1,237 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// isWordGap returns true if s only has characters that separate words in
+// identifiers: underscores, dashes and spaces
+func isWordGap(s string) bool {
+	for _, r := range s {
+		if r != '_' && r != '-' && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// joinWords calls fn for each word in s and joins the results with sep.
+// Other characters between words, like "::" and ": ", are kept as are
+// leading and trailing non-word characters.
+func joinWords(s string, words [][2]int, sep string, fn func(i int, word string) string) string {
+	var b strings.Builder
+	b.Grow(len(s) + len(words)*len(sep))
+	prev := 0
+	for i, w := range words {
+		gap := s[prev:w[0]]
+		if i > 0 && isWordGap(gap) {
+			gap = sep
+		}
+		b.WriteString(gap)
+		b.WriteString(fn(i, s[w[0]:w[1]]))
+		prev = w[1]
+	}
+	b.WriteString(s[prev:])
+	return b.String()
+}
+
+// titleCase upper cases the first letter of word and lower cases the rest
+func titleCase(word string) string {
+	_, n := utf8.DecodeRuneInString(word)
+	return strings.ToUpper(word[:n]) + strings.ToLower(word[n:])
+}
+
+// capitalize returns word in title case, or acronyms like "url" in their
+// registered form
+func (rs *ruleSet) capitalize(word string) string {
+	if a, ok := rs.acronymForm(word); ok {
+		return a
+	}
+	return titleCase(word)
+}
+
+// lower returns word in lower case, except for acronyms that are returned
+// in their registered form
+func (rs *ruleSet) lower(word string) string {
+	if a, ok := rs.acronymForm(word); ok {
+		return a
+	}
+	return strings.ToLower(word)
+}
+
+func (in *Inflector) separate(s string, sep string) string {
+	rs := in.load()
+	return joinWords(s, identifierWords(s, rs.acronyms), sep, func(i int, word string) string {
+		return strings.ToLower(word)
+	})
+}
+
+// Camelize converts an identifier to UpperCamelCase, e.g. "user_account"
+// becomes "UserAccount". Acronyms are written in their registered form, so
+// "api_key" becomes "APIKey".
+func (in *Inflector) Camelize(s string) string {
+	rs := in.load()
+	return joinWords(s, identifierWords(s, rs.acronyms), "", func(i int, word string) string {
+		return rs.capitalize(word)
+	})
+}
+
+// CamelizeLower converts an identifier to lowerCamelCase, e.g.
+// "user_account" becomes "userAccount" and "api_key" becomes "apiKey".
+func (in *Inflector) CamelizeLower(s string) string {
+	rs := in.load()
+	return joinWords(s, identifierWords(s, rs.acronyms), "", func(i int, word string) string {
+		if i == 0 {
+			return strings.ToLower(word)
+		}
+		return rs.capitalize(word)
+	})
+}
+
+// Underscore converts an identifier to snake_case, e.g. "UserAccount"
+// becomes "user_account" and "APIKey" becomes "api_key".
+func (in *Inflector) Underscore(s string) string {
+	return in.separate(s, "_")
+}
+
+// Dasherize converts an identifier to kebab-case, e.g. "user_account" and
+// "UserAccount" become "user-account".
+func (in *Inflector) Dasherize(s string) string {
+	return in.separate(s, "-")
+}
+
+// joinApostrophes joins words separated by an apostrophe, so "don't" is
+// one word
+func joinApostrophes(s string, words [][2]int) [][2]int {
+	var res [][2]int
+	for i, w := range words {
+		if i > 0 {
+			if gap := s[words[i-1][1]:w[0]]; gap == "'" || gap == "’" {
+				res[len(res)-1][1] = w[1]
+				continue
+			}
+		}
+		res = append(res, w)
+	}
+	return res
+}
+
+// humanWords returns the words of s for Humanize and Titleize: without
+// a trailing "id" and without non-word characters around them
+func (rs *ruleSet) humanWords(s string) (string, [][2]int) {
+	words := joinApostrophes(s, identifierWords(s, rs.acronyms))
+	n := len(words)
+	if n > 1 && strings.EqualFold(s[words[n-1][0]:words[n-1][1]], "id") {
+		words = words[:n-1]
+		n--
+	}
+	if n == 0 {
+		return "", nil
+	}
+	start, end := words[0][0], words[n-1][1]
+	res := make([][2]int, n)
+	for i, w := range words {
+		res[i] = [2]int{w[0] - start, w[1] - start}
+	}
+	return s[start:end], res
+}
+
+// Humanize converts an identifier to words for showing to people, e.g.
+// "employee_salary" becomes "Employee salary". A trailing "id" is removed,
+// so "author_id" becomes "Author", and acronyms are kept, so "api_key"
+// becomes "API key".
+func (in *Inflector) Humanize(s string) string {
+	rs := in.load()
+	s, words := rs.humanWords(s)
+	return joinWords(s, words, " ", func(i int, word string) string {
+		if i == 0 {
+			return rs.capitalize(word)
+		}
+		return rs.lower(word)
+	})
+}
+
+// Titleize converts an identifier or a sentence to a title, with every
+// word capitalized, e.g. "man_from_the_boondocks" becomes
+// "Man From The Boondocks". Apostrophes don't start a new word, so
+// "don't stop" becomes "Don't Stop".
+func (in *Inflector) Titleize(s string) string {
+	rs := in.load()
+	s, words := rs.humanWords(s)
+	return joinWords(s, words, " ", func(i int, word string) string {
+		return rs.capitalize(word)
+	})
+}
+
+// Tableize returns the name of a table for a model name, e.g. "UserAccount"
+// becomes "user_accounts" and "Person" becomes "people".
+func (in *Inflector) Tableize(s string) string {
+	return in.Underscore(in.PluralizeIdentifier(s))
+}
+
+// Classify returns the name of a model for a table name, e.g.
+// "user_accounts" becomes "UserAccount". A schema prefix is removed, so
+// "public.people" becomes "Person".
+func (in *Inflector) Classify(s string) string {
+	if i := strings.LastIndexByte(s, '.'); i >= 0 {
+		s = s[i+1:]
+	}
+	return in.Camelize(in.SingularizeIdentifier(s))
+}
+
+// ForeignKey returns the name of a foreign key column for a model name,
+// e.g. "UserAccount" becomes "user_account_id". A package or module prefix
+// is removed, so "admin.Post" and "Admin::Post" become "post_id".
+func (in *Inflector) ForeignKey(s string) string {
+	if i := strings.LastIndexAny(s, ".:"); i >= 0 {
+		s = s[i+1:]
+	}
+	return in.Underscore(s) + "_id"
+}
+
+// Camelize converts an identifier to UpperCamelCase.
+func Camelize(s string) string {
+	return defaultInflector.Camelize(s)
+}
+
+// CamelizeLower converts an identifier to lowerCamelCase.
+func CamelizeLower(s string) string {
+	return defaultInflector.CamelizeLower(s)
+}
+
+// Underscore converts an identifier to snake_case.
+func Underscore(s string) string {
+	return defaultInflector.Underscore(s)
+}
+
+// Dasherize converts an identifier to kebab-case.
+func Dasherize(s string) string {
+	return defaultInflector.Dasherize(s)
+}
+
+// Humanize converts an identifier to words for showing to people.
+func Humanize(s string) string {
+	return defaultInflector.Humanize(s)
+}
+
+// Titleize converts an identifier or a sentence to a title.
+func Titleize(s string) string {
+	return defaultInflector.Titleize(s)
+}
+
+// Tableize returns the name of a table for a model name.
+func Tableize(s string) string {
+	return defaultInflector.Tableize(s)
+}
+
+// Classify returns the name of a model for a table name.
+func Classify(s string) string {
+	return defaultInflector.Classify(s)
+}
+
+// ForeignKey returns the name of a foreign key column for a model name.
+func ForeignKey(s string) string {
+	return defaultInflector.ForeignKey(s)
+}
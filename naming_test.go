@@ -0,0 +1,111 @@
+package inflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCamelize(t *testing.T) {
+	tests := [][]string{
+		{"user_account", "UserAccount", "userAccount"},
+		{"user-account", "UserAccount", "userAccount"},
+		{"user account", "UserAccount", "userAccount"},
+		{"USER_ACCOUNT", "UserAccount", "userAccount"},
+		{"UserAccount", "UserAccount", "userAccount"},
+		{"api_key", "APIKey", "apiKey"},
+		{"user_id", "UserID", "userID"},
+		{"user_ids", "UserIDs", "userIDs"},
+		{"http_server_url", "HTTPServerURL", "httpServerURL"},
+		{"admin::user_account", "Admin::UserAccount", "admin::UserAccount"},
+		{"_private_field", "_PrivateField", "_privateField"},
+		{"école_primaire", "ÉcolePrimaire", "écolePrimaire"},
+		{"", "", ""},
+	}
+	for i, test := range tests {
+		assert.Equal(t, test[1], Camelize(test[0]), "s: %s, i: %d", test[0], i)
+		assert.Equal(t, test[2], CamelizeLower(test[0]), "s: %s, i: %d", test[0], i)
+	}
+}
+
+func TestUnderscore(t *testing.T) {
+	tests := [][]string{
+		{"UserAccount", "user_account", "user-account"},
+		{"userAccount", "user_account", "user-account"},
+		{"user-account", "user_account", "user-account"},
+		{"USER_ACCOUNT", "user_account", "user-account"},
+		{"APIKey", "api_key", "api-key"},
+		{"UserIDs", "user_ids", "user-ids"},
+		{"HTTPServerURL", "http_server_url", "http-server-url"},
+		{"Admin::UserAccount", "admin::user_account", "admin::user-account"},
+		{"ÉcolePrimaire", "école_primaire", "école-primaire"},
+		{"Version2Beta", "version2_beta", "version2-beta"},
+	}
+	for i, test := range tests {
+		assert.Equal(t, test[1], Underscore(test[0]), "s: %s, i: %d", test[0], i)
+		assert.Equal(t, test[2], Dasherize(test[0]), "s: %s, i: %d", test[0], i)
+	}
+	for i, test := range tests {
+		assert.Equal(t, test[1], Underscore(Camelize(test[1])), "s: %s, i: %d", test[1], i)
+	}
+}
+
+func TestHumanize(t *testing.T) {
+	tests := [][]string{
+		{"employee_salary", "Employee salary", "Employee Salary"},
+		{"author_id", "Author", "Author"},
+		{"AuthorID", "Author", "Author"},
+		{"id", "ID", "ID"},
+		{"_private_field_", "Private field", "Private Field"},
+		{"api_key", "API key", "API Key"},
+		{"user_urls", "User URLs", "User URLs"},
+		{"man from the boondocks", "Man from the boondocks", "Man From The Boondocks"},
+		{"x-men: the last stand", "X men: the last stand", "X Men: The Last Stand"},
+		{"raiders_of_the_lost_ark", "Raiders of the lost ark", "Raiders Of The Lost Ark"},
+		{"ÉCOLE_PRIMAIRE", "École primaire", "École Primaire"},
+		{"don't stop believing", "Don't stop believing", "Don't Stop Believing"},
+		{"the cat’s whiskers", "The cat’s whiskers", "The Cat’s Whiskers"},
+		{"rock 'n' roll", "Rock 'n' roll", "Rock 'N' Roll"},
+		{"__", "", ""},
+	}
+	for i, test := range tests {
+		assert.Equal(t, test[1], Humanize(test[0]), "s: %s, i: %d", test[0], i)
+		assert.Equal(t, test[2], Titleize(test[0]), "s: %s, i: %d", test[0], i)
+	}
+}
+
+func TestNamingAcronyms(t *testing.T) {
+	in := New()
+	assert.Equal(t, "SslError", in.Camelize("ssl_error"))
+	in.AddAcronym("SSL")
+	assert.Equal(t, "SSLError", in.Camelize("ssl_error"))
+	assert.Equal(t, "ssl_error", in.Underscore("SSLError"))
+	assert.Equal(t, "SSL error", in.Humanize("ssl_error"))
+	assert.Equal(t, "SslError", Camelize("ssl_error"))
+}
+
+func TestTableize(t *testing.T) {
+	tests := [][]string{
+		{"UserAccount", "user_accounts", "user_account_id"},
+		{"Person", "people", "person_id"},
+		{"Address", "addresses", "address_id"},
+		{"OrderItemCategory", "order_item_categories", "order_item_category_id"},
+		{"APIKey", "api_keys", "api_key_id"},
+		{"UserURL", "user_urls", "user_url_id"},
+		{"Sheep", "sheep", "sheep_id"},
+	}
+	for i, test := range tests {
+		assert.Equal(t, test[1], Tableize(test[0]), "s: %s, i: %d", test[0], i)
+		assert.Equal(t, test[0], Classify(test[1]), "s: %s, i: %d", test[1], i)
+		assert.Equal(t, test[2], ForeignKey(test[0]), "s: %s, i: %d", test[0], i)
+	}
+	assert.Equal(t, "Person", Classify("public.people"))
+	assert.Equal(t, "UserAccount", Classify("UserAccounts"))
+	assert.Equal(t, "post_id", ForeignKey("admin.Post"))
+	assert.Equal(t, "post_id", ForeignKey("Admin::Post"))
+
+	in := New()
+	in.AddIrregularRule("datum", "data")
+	assert.Equal(t, "sensor_data", in.Tableize("SensorDatum"))
+	assert.Equal(t, "SensorDatum", in.Classify("sensor_data"))
+}
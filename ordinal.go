@@ -0,0 +1,57 @@
+package inflect
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ordinal forms of number words that don't just add "th"
+var irregularOrdinals = map[string]string{
+	"one":    "first",
+	"two":    "second",
+	"three":  "third",
+	"five":   "fifth",
+	"eight":  "eighth",
+	"nine":   "ninth",
+	"twelve": "twelfth",
+}
+
+// Ordinal returns the suffix of the ordinal form of n, e.g. "st" for 1 and
+// 21, "nd" for 2, "rd" for 23 and "th" for 11, 12 and 13.
+func Ordinal(n int) string {
+	u := abs(int64(n))
+	if r := u % 100; r >= 11 && r <= 13 {
+		return "th"
+	}
+	switch u % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+// Ordinalize returns n with its ordinal suffix, e.g. "1st", "22nd" or
+// "113th".
+func Ordinalize(n int) string {
+	return strconv.Itoa(n) + Ordinal(n)
+}
+
+// OrdinalWord returns the ordinal form of n in words, e.g. "first",
+// "eleventh" or "twenty-first".
+func OrdinalWord(n int) string {
+	s := CardinalWord(int64(n), American)
+	i := strings.LastIndexAny(s, " -") + 1
+	last := s[i:]
+	if w, ok := irregularOrdinals[last]; ok {
+		return s[:i] + w
+	}
+	if strings.HasSuffix(last, "y") {
+		// "twenty" becomes "twentieth"
+		return s[:len(s)-1] + "ieth"
+	}
+	return s + "th"
+}
@@ -0,0 +1,67 @@
+package inflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrdinalize(t *testing.T) {
+	tests := []struct {
+		n int
+		s string
+	}{
+		{0, "0th"},
+		{1, "1st"},
+		{2, "2nd"},
+		{3, "3rd"},
+		{4, "4th"},
+		{11, "11th"},
+		{12, "12th"},
+		{13, "13th"},
+		{21, "21st"},
+		{22, "22nd"},
+		{23, "23rd"},
+		{101, "101st"},
+		{111, "111th"},
+		{112, "112th"},
+		{1003, "1003rd"},
+		{-1, "-1st"},
+		{-12, "-12th"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.s, Ordinalize(test.n), "n: %d", test.n)
+	}
+}
+
+func TestOrdinalWord(t *testing.T) {
+	tests := []struct {
+		n int
+		s string
+	}{
+		{0, "zeroth"},
+		{1, "first"},
+		{2, "second"},
+		{3, "third"},
+		{4, "fourth"},
+		{5, "fifth"},
+		{8, "eighth"},
+		{9, "ninth"},
+		{11, "eleventh"},
+		{12, "twelfth"},
+		{13, "thirteenth"},
+		{20, "twentieth"},
+		{21, "twenty-first"},
+		{42, "forty-second"},
+		{99, "ninety-ninth"},
+		{100, "one hundredth"},
+		{103, "one hundred third"},
+		{1000, "one thousandth"},
+		{1000000, "one millionth"},
+		{1000012, "one million twelfth"},
+		{-1, "minus first"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.s, OrdinalWord(test.n), "n: %d", test.n)
+	}
+}
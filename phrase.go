@@ -0,0 +1,175 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+)
+
+// prepositions and particles that follow the head noun in compounds like
+// "mother-in-law", "man-of-war" or "passer-by"
+var phrasePrepositions = map[string]bool{
+	"about": true,
+	"at":    true,
+	"by":    true,
+	"de":    true,
+	"down":  true,
+	"for":   true,
+	"from":  true,
+	"in":    true,
+	"of":    true,
+	"off":   true,
+	"on":    true,
+	"out":   true,
+	"over":  true,
+	"to":    true,
+	"up":    true,
+	"with":  true,
+}
+
+// adjectives that follow the noun, as in "attorney general" or "heir apparent"
+var postpositiveAdjectives = map[string]bool{
+	"apparent":        true,
+	"designate":       true,
+	"elect":           true,
+	"emeritus":        true,
+	"errant":          true,
+	"extraordinary":   true,
+	"general":         true,
+	"laureate":        true,
+	"martial":         true,
+	"militant":        true,
+	"plenipotentiary": true,
+	"politic":         true,
+	"presumptive":     true,
+	"public":          true,
+	"royal":           true,
+}
+
+// in "major general" the last word is the head noun
+var generalRanks = map[string]bool{
+	"brigadier":  true,
+	"lieutenant": true,
+	"major":      true,
+}
+
+func isPhraseSeparator(r rune) bool {
+	return r == '-' || unicode.IsSpace(r)
+}
+
+// phraseWords returns byte spans of words in a phrase
+func phraseWords(phrase string) [][2]int {
+	var res [][2]int
+	start := -1
+	for i, r := range phrase {
+		if isPhraseSeparator(r) {
+			if start >= 0 {
+				res = append(res, [2]int{start, i})
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		res = append(res, [2]int{start, len(phrase)})
+	}
+	return res
+}
+
+// compounds like "passer-by" where the head noun comes before a final
+// particle, unlike "cover-up" or "stand-in", keyed by the head noun
+var headFirstCompounds = map[string]string{
+	"hanger": "on",
+	"looker": "on",
+	"passer": "by",
+	"runner": "up",
+}
+
+// isHeadFirst returns true if word, like "passer" or "passers", is the
+// head noun when followed by particle
+func isHeadFirst(word, particle string) bool {
+	p, ok := headFirstCompounds[word]
+	if !ok {
+		p, ok = headFirstCompounds[strings.TrimSuffix(word, "s")]
+	}
+	return ok && p == particle
+}
+
+// headNoun returns the index of the word that should be inflected
+func headNoun(words []string) int {
+	n := len(words)
+	for i := 1; i < n; i++ {
+		if !phrasePrepositions[words[i]] {
+			continue
+		}
+		// "passer-by", but "stand-in" and "cover-up"
+		if i == n-1 && !isHeadFirst(words[i-1], words[i]) {
+			return n - 1
+		}
+		// "mother-in-law", "coat of arms"
+		return i - 1
+	}
+	// "attorney general", "heir apparent" but "major general"
+	if n >= 2 && postpositiveAdjectives[words[n-1]] && !generalRanks[words[n-2]] {
+		return n - 2
+	}
+	return n - 1
+}
+
+func (in *Inflector) inflectPhrase(phrase string, plural bool) string {
+	inflectWord := in.ToSingular
+	if plural {
+		inflectWord = in.ToPlural
+	}
+	spans := phraseWords(phrase)
+	if len(spans) < 2 {
+		return inflectWord(phrase)
+	}
+	// phrases can be added as irregular or uncountable words
+	rs := in.load()
+	token := strings.ToLower(phrase)
+	if _, ok := rs.irregularSingles[token]; ok {
+		return inflectWord(phrase)
+	}
+	if _, ok := rs.irregularPlurals[token]; ok {
+		return inflectWord(phrase)
+	}
+	if _, ok := rs.uncountables[token]; ok {
+		return phrase
+	}
+
+	words := make([]string, len(spans))
+	for i, span := range spans {
+		words[i] = strings.ToLower(phrase[span[0]:span[1]])
+	}
+	span := spans[headNoun(words)]
+	head := inflectWord(phrase[span[0]:span[1]])
+	return phrase[:span[0]] + head + phrase[span[1]:]
+}
+
+// ToPluralPhrase pluralizes a compound noun by pluralizing its head noun
+// and leaving other words intact, e.g. "mother-in-law" becomes
+// "mothers-in-law" and "attorney general" becomes "attorneys general".
+// Phrases that are not compounds are pluralized like by ToPlural.
+func (in *Inflector) ToPluralPhrase(phrase string) string {
+	return in.inflectPhrase(phrase, true)
+}
+
+// ToSingularPhrase singularizes a compound noun by singularizing its head
+// noun, e.g. "passers-by" becomes "passer-by".
+func (in *Inflector) ToSingularPhrase(phrase string) string {
+	return in.inflectPhrase(phrase, false)
+}
+
+// ToPluralPhrase pluralizes a compound noun by pluralizing its head noun.
+func ToPluralPhrase(phrase string) string {
+	return defaultInflector.ToPluralPhrase(phrase)
+}
+
+// ToSingularPhrase singularizes a compound noun by singularizing its head
+// noun.
+func ToSingularPhrase(phrase string) string {
+	return defaultInflector.ToSingularPhrase(phrase)
+}
@@ -0,0 +1,63 @@
+package inflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var phraseTests = [][]string{
+	{"mother-in-law", "mothers-in-law"},
+	{"Mother-In-Law", "Mothers-In-Law"},
+	{"man-of-war", "men-of-war"},
+	{"editor-in-chief", "editors-in-chief"},
+	{"commander in chief", "commanders in chief"},
+	{"lady-in-waiting", "ladies-in-waiting"},
+	{"coat of arms", "coats of arms"},
+	{"bill of sale", "bills of sale"},
+	{"passer-by", "passers-by"},
+	{"runner-up", "runners-up"},
+	{"hanger-on", "hangers-on"},
+	{"looker-on", "lookers-on"},
+	{"cover-up", "cover-ups"},
+	{"power-up", "power-ups"},
+	{"sleep-over", "sleep-overs"},
+	{"stand-in", "stand-ins"},
+	{"add-on", "add-ons"},
+	{"attorney general", "attorneys general"},
+	{"ATTORNEY GENERAL", "ATTORNEYS GENERAL"},
+	{"surgeon general", "surgeons general"},
+	{"major general", "major generals"},
+	{"court martial", "courts martial"},
+	{"heir apparent", "heirs apparent"},
+	{"poet laureate", "poets laureate"},
+	{"notary public", "notaries public"},
+	{"forget-me-not", "forget-me-nots"},
+	{"merry-go-round", "merry-go-rounds"},
+	{"user account", "user accounts"},
+	{"  grown  woman ", "  grown  women "},
+	{"person", "people"},
+	{"", ""},
+}
+
+func TestToPluralPhrase(t *testing.T) {
+	for i, test := range phraseTests {
+		assert.Equal(t, test[1], ToPluralPhrase(test[0]), "s: %s, i: %d", test[0], i)
+	}
+}
+
+func TestToSingularPhrase(t *testing.T) {
+	for i, test := range phraseTests {
+		assert.Equal(t, test[0], ToSingularPhrase(test[1]), "s: %s, i: %d", test[1], i)
+	}
+}
+
+func TestPhraseRules(t *testing.T) {
+	in := New()
+	assert.Equal(t, "goods-for-nothing", in.ToPluralPhrase("good-for-nothing"))
+	in.AddIrregularRule("good-for-nothing", "good-for-nothings")
+	assert.Equal(t, "good-for-nothings", in.ToPluralPhrase("good-for-nothing"))
+	assert.Equal(t, "good-for-nothing", in.ToSingularPhrase("good-for-nothings"))
+	in.AddUncountableRule("rank and file")
+	assert.Equal(t, "rank and file", in.ToPluralPhrase("rank and file"))
+}
@@ -0,0 +1,184 @@
+package inflect
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Integer is a constraint for the integer types accepted as counts by
+// Pluralize and PluralizeWith.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// toInt64 converts n to int64. Unsigned values above math.MaxInt64 become
+// math.MaxInt64, which is enough to choose the form of a word; bigDigits
+// keeps them for writing the count.
+func toInt64[T Integer](n T) int64 {
+	if n > 0 && int64(n) < 0 {
+		return math.MaxInt64
+	}
+	return int64(n)
+}
+
+// bigDigits returns n in digits if it's an unsigned value above
+// math.MaxInt64, and "" otherwise
+func bigDigits[T Integer](n T) string {
+	if n > 0 && int64(n) < 0 {
+		return strconv.FormatUint(uint64(n), 10)
+	}
+	return ""
+}
+
+// PluralizeOptions tells PluralizeWith how to write a count and a word.
+// The zero value writes "1 apple", "2 apples" and "0 apples".
+type PluralizeOptions struct {
+	// FormatCount, if set, writes the count. It takes precedence over
+	// ThousandsSeparator and SpellOut. Unsigned counts above math.MaxInt64
+	// don't fit in its argument and are always written in digits.
+	FormatCount func(n int64) string
+	// ThousandsSeparator separates groups of 3 digits, e.g. "," writes
+	// 1234567 as "1,234,567".
+	ThousandsSeparator string
+	// SpellOut writes small counts in words, e.g. "three apples".
+	SpellOut SpellOut
+	// Zero, if not empty, is written instead of a count of 0, e.g. "no"
+	// gives "no apples".
+	Zero string
+	// Separator goes between the count and the word. The default is
+	// a space; use "\u00a0" for a non-breaking space.
+	Separator string
+	// SingularZero treats 0 as singular, e.g. "0 apple".
+	SingularZero bool
+	// SingularMinusOne treats -1 as singular, e.g. "-1 degree".
+	SingularMinusOne bool
+	// Article writes a count of 1 as "a" or "an", e.g. "an apple".
+	Article bool
+	// Precision is the number of digits after the decimal point written by
+	// PluralizeFloat, e.g. 2 writes 1.5 as "1.50". 0 writes as many digits
+	// as needed, e.g. "1.5" and "2".
+	Precision int
+}
+
+// formatThousands writes n in digits, with sep between groups of 3 digits
+func formatThousands(n int64, sep string) string {
+	return groupThousands(strconv.FormatInt(n, 10), sep)
+}
+
+// groupThousands puts sep between groups of 3 digits in s, which is
+// an integer with an optional sign
+func groupThousands(s string, sep string) string {
+	if sep == "" {
+		return s
+	}
+	start := 0
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		start = 1
+	}
+	ndigits := len(s) - start
+	if ndigits <= 3 {
+		return s
+	}
+	b := make([]byte, 0, len(s)+(ndigits-1)/3*len(sep))
+	b = append(b, s[:start]...)
+	for i := start; i < len(s); i++ {
+		if i > start && (len(s)-i)%3 == 0 {
+			b = append(b, sep...)
+		}
+		b = append(b, s[i])
+	}
+	return string(b)
+}
+
+// formatCount writes n, or digits if it's not empty
+func (o *PluralizeOptions) formatCount(n int64, digits string) string {
+	if digits != "" {
+		return groupThousands(digits, o.ThousandsSeparator)
+	}
+	if o.FormatCount != nil {
+		return o.FormatCount(n)
+	}
+	if s := o.SpellOut; s.Threshold > 0 && abs(n) < uint64(s.Threshold) {
+		return CardinalWord(n, s.Style)
+	}
+	return formatThousands(n, o.ThousandsSeparator)
+}
+
+func (o *PluralizeOptions) isSingular(n int64) bool {
+	return n == 1 || (n == 0 && o.SingularZero) || (n == -1 && o.SingularMinusOne)
+}
+
+// PluralizeWith writes count followed by the singular or plural form of
+// word, as configured by opts, e.g. "1,024 files", "no files" or
+// "an hour".
+func (in *Inflector) PluralizeWith(word string, count int64, opts PluralizeOptions) string {
+	return in.pluralizeWith(word, count, "", opts)
+}
+
+// pluralizeWith is PluralizeWith with count written as digits, if not empty
+func (in *Inflector) pluralizeWith(word string, count int64, digits string, opts PluralizeOptions) string {
+	var res string
+	if opts.isSingular(count) {
+		res = in.ToSingular(word)
+	} else {
+		res = in.ToPlural(word)
+	}
+
+	var prefix string
+	switch {
+	case count == 0 && opts.Zero != "":
+		prefix = opts.Zero
+	case count == 1 && opts.Article:
+		prefix = in.Article(res)
+	default:
+		prefix = opts.formatCount(count, digits)
+	}
+	sep := opts.Separator
+	if sep == "" {
+		sep = " "
+	}
+	return prefix + sep + res
+}
+
+// PluralizeFloat writes count followed by the singular or plural form of
+// word, as configured by opts. The word is singular only if count is
+// exactly 1 and written without digits after the decimal point, so it's
+// "1 hour" but "1.5 hours", "0.5 hours" and "1.0 hours" with Precision 1.
+// Whole counts written without decimals use all of opts like
+// PluralizeWith; FormatCount and SpellOut are not used for other counts.
+func (in *Inflector) PluralizeFloat(word string, count float64, opts PluralizeOptions) string {
+	if opts.Precision <= 0 && count == math.Trunc(count) && math.Abs(count) < math.MaxInt64 {
+		return in.PluralizeWith(word, int64(count), opts)
+	}
+	prec := opts.Precision
+	if prec <= 0 {
+		prec = -1
+	}
+	s := strconv.FormatFloat(count, 'f', prec, 64)
+	if opts.ThousandsSeparator != "" {
+		intPart, frac := s, ""
+		if i := strings.IndexByte(s, '.'); i >= 0 {
+			intPart, frac = s[:i], s[i:]
+		}
+		s = groupThousands(intPart, opts.ThousandsSeparator) + frac
+	}
+	sep := opts.Separator
+	if sep == "" {
+		sep = " "
+	}
+	return s + sep + in.ToPlural(word)
+}
+
+// PluralizeWith writes count, which can be of any integer type, followed
+// by the singular or plural form of word, as configured by opts.
+func PluralizeWith[T Integer](word string, count T, opts PluralizeOptions) string {
+	return defaultInflector.pluralizeWith(word, toInt64(count), bigDigits(count), opts)
+}
+
+// PluralizeFloat writes count followed by the singular or plural form of
+// word, as configured by opts.
+func PluralizeFloat(word string, count float64, opts PluralizeOptions) string {
+	return defaultInflector.PluralizeFloat(word, count, opts)
+}
@@ -0,0 +1,106 @@
+package inflect
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatThousands(t *testing.T) {
+	tests := []struct {
+		n int64
+		s string
+	}{
+		{0, "0"},
+		{999, "999"},
+		{1000, "1,000"},
+		{-1000, "-1,000"},
+		{-999, "-999"},
+		{123456, "123,456"},
+		{1234567, "1,234,567"},
+		{math.MinInt64, "-9,223,372,036,854,775,808"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.s, formatThousands(test.n, ","), "n: %d", test.n)
+	}
+	assert.Equal(t, "1 234 567", formatThousands(1234567, " "))
+	assert.Equal(t, "1234567", formatThousands(1234567, ""))
+}
+
+func TestPluralizeWith(t *testing.T) {
+	tests := []struct {
+		count int64
+		opts  PluralizeOptions
+		s     string
+	}{
+		{1, PluralizeOptions{}, "1 file"},
+		{2, PluralizeOptions{}, "2 files"},
+		{0, PluralizeOptions{}, "0 files"},
+		{-1, PluralizeOptions{}, "-1 files"},
+		{1024, PluralizeOptions{ThousandsSeparator: ","}, "1,024 files"},
+		{0, PluralizeOptions{Zero: "no"}, "no files"},
+		{2, PluralizeOptions{Zero: "no"}, "2 files"},
+		{0, PluralizeOptions{SingularZero: true}, "0 file"},
+		{-1, PluralizeOptions{SingularMinusOne: true}, "-1 file"},
+		{-2, PluralizeOptions{SingularMinusOne: true}, "-2 files"},
+		{3, PluralizeOptions{Separator: "\u00a0"}, "3\u00a0files"},
+		{3, PluralizeOptions{SpellOut: SpellOut{Threshold: 10}}, "three files"},
+		{12345, PluralizeOptions{SpellOut: SpellOut{Threshold: 10}, ThousandsSeparator: ","}, "12,345 files"},
+		{1, PluralizeOptions{Article: true}, "a file"},
+		{1, PluralizeOptions{Article: true, SpellOut: SpellOut{Threshold: 10}}, "a file"},
+		{5, PluralizeOptions{FormatCount: func(n int64) string { return fmt.Sprintf("[%d]", n) }}, "[5] files"},
+	}
+	for i, test := range tests {
+		assert.Equal(t, test.s, PluralizeWith("file", test.count, test.opts), "i: %d", i)
+	}
+	assert.Equal(t, "an hour", PluralizeWith("hours", 1, PluralizeOptions{Article: true}))
+	assert.Equal(t, "no people", PluralizeWith("person", 0, PluralizeOptions{Zero: "no"}))
+}
+
+func TestPluralizeGeneric(t *testing.T) {
+	type fileCount uint16
+	assert.Equal(t, "5 bytes", Pluralize("byte", int64(5), true))
+	assert.Equal(t, "1 byte", Pluralize("byte", uint64(1), true))
+	assert.Equal(t, "bytes", Pluralize("byte", uint8(0), false))
+	assert.Equal(t, "-3 degrees", Pluralize("degree", int8(-3), true))
+	assert.Equal(t, "1 file", Pluralize("files", fileCount(1), true))
+	assert.Equal(t, "18446744073709551615 bytes", Pluralize("byte", uint64(math.MaxUint64), true))
+	assert.Equal(t, "9223372036854775808 bytes", Pluralize("byte", uint64(math.MaxInt64)+1, true))
+	assert.Equal(t, "18,446,744,073,709,551,615 bytes", PluralizeWith("byte", uint64(math.MaxUint64), PluralizeOptions{ThousandsSeparator: ","}))
+	assert.Equal(t, "4,096 bytes", PluralizeWith("byte", uint32(4096), PluralizeOptions{ThousandsSeparator: ","}))
+}
+
+func TestPluralizeFloat(t *testing.T) {
+	tests := []struct {
+		count float64
+		opts  PluralizeOptions
+		s     string
+	}{
+		{1, PluralizeOptions{}, "1 hour"},
+		{1.0, PluralizeOptions{}, "1 hour"},
+		{1.5, PluralizeOptions{}, "1.5 hours"},
+		{0.5, PluralizeOptions{}, "0.5 hours"},
+		{2, PluralizeOptions{}, "2 hours"},
+		{0, PluralizeOptions{}, "0 hours"},
+		{-1, PluralizeOptions{}, "-1 hours"},
+		{-1, PluralizeOptions{SingularMinusOne: true}, "-1 hour"},
+		{1, PluralizeOptions{Precision: 1}, "1.0 hours"},
+		{1.5, PluralizeOptions{Precision: 2}, "1.50 hours"},
+		{0.999, PluralizeOptions{Precision: 1}, "1.0 hours"},
+		{1234.5, PluralizeOptions{ThousandsSeparator: ","}, "1,234.5 hours"},
+		{-1234.5, PluralizeOptions{ThousandsSeparator: ","}, "-1,234.5 hours"},
+		{1234, PluralizeOptions{ThousandsSeparator: ","}, "1,234 hours"},
+		{1, PluralizeOptions{Article: true}, "an hour"},
+		{0, PluralizeOptions{Zero: "no"}, "no hours"},
+		{3, PluralizeOptions{SpellOut: SpellOut{Threshold: 10}}, "three hours"},
+		{2.5, PluralizeOptions{SpellOut: SpellOut{Threshold: 10}}, "2.5 hours"},
+		{math.Inf(1), PluralizeOptions{}, "+Inf hours"},
+		{math.NaN(), PluralizeOptions{}, "NaN hours"},
+		{1e20, PluralizeOptions{}, "100000000000000000000 hours"},
+	}
+	for i, test := range tests {
+		assert.Equal(t, test.s, PluralizeFloat("hour", test.count, test.opts), "i: %d", i)
+	}
+}
@@ -0,0 +1,94 @@
+package inflect
+
+var portugueseIrregularRules = [][]string{
+	// Words ending in `ão` that become `ãos`.
+	{"mão", "mãos"},
+	{"irmão", "irmãos"},
+	{"cidadão", "cidadãos"},
+	{"cristão", "cristãos"},
+	{"órgão", "órgãos"},
+	{"órfão", "órfãos"},
+	{"bênção", "bênçãos"},
+	{"grão", "grãos"},
+	{"chão", "chãos"},
+	{"sótão", "sótãos"},
+	// Words ending in `ão` that become `ães`.
+	{"pão", "pães"},
+	{"cão", "cães"},
+	{"alemão", "alemães"},
+	{"capitão", "capitães"},
+	{"charlatão", "charlatães"},
+	{"escrivão", "escrivães"},
+	{"tabelião", "tabeliães"},
+	// Singular words ending in `s`.
+	{"país", "países"},
+	{"gás", "gases"},
+	{"ás", "ases"},
+	{"deus", "deuses"},
+	// Other irregular rules.
+	{"fóssil", "fósseis"},
+	{"réptil", "répteis"},
+}
+
+var portuguesePluralizationRules = [][]string{
+	{`/$/`, `s`},
+	{`/s$/i`, `s`},
+	{`/x$/i`, `$0`},
+	{`/([rz])$/i`, `$1es`},
+	{`/m$/i`, `ns`},
+	{`/ão$/i`, `ões`},
+	{`/al$/i`, `ais`},
+	{`/el$/i`, `éis`},
+	{`/ol$/i`, `óis`},
+	{`/ul$/i`, `uis`},
+	{`/il$/i`, `is`},
+	// Unstressed last syllable, e.g. nível, níveis.
+	{`/([áéíóúâêô][^aeiouáéíóúâêôãõ]{1,3})el$/i`, `$1eis`},
+	{`/ês$/i`, `eses`},
+}
+
+var portugueseSingularizationRules = [][]string{
+	{`/s$/i`, ``},
+	{`/([aeiouáéíóúâêô][rz])es$/i`, `$1`},
+	// Unstressed last syllable, e.g. árvore, árvores.
+	{`/([áéíóúâêô][^aeiouáéíóúâêôãõ]{1,3})ores$/i`, `$1ore`},
+	{`/ns$/i`, `m`},
+	{`/(ões|ães)$/i`, `ão`},
+	{`/ais$/i`, `al`},
+	{`/éis$/i`, `el`},
+	{`/óis$/i`, `ol`},
+	{`/uis$/i`, `ul`},
+	{`/([áéíóúâêô][^aeiouáéíóúâêôãõ]{1,3})eis$/i`, `$1el`},
+	{`/([cdmnu]|gl)eses$/i`, `$1ês`},
+	// Plurals of words ending in `ese`, e.g. hipóteses.
+	{`/([áéíóúâêô][^aeiouáéíóúâêôãõ]{1,3})eses$/i`, `$1ese`},
+	// Already singular.
+	{`/ês$/i`, `$0`},
+}
+
+var portugueseUncountableRules = []string{
+	"atlas",
+	"lápis",
+	"óculos",
+	"ônibus",
+	"pires",
+	"tênis",
+	"tórax",
+	"vírus",
+}
+
+var portugueseTables = &ruleTables{
+	irregular:   portugueseIrregularRules,
+	plural:      portuguesePluralizationRules,
+	singular:    portugueseSingularizationRules,
+	uncountable: portugueseUncountableRules,
+}
+
+// NewPortuguese returns an Inflector initialized with Portuguese rules.
+func NewPortuguese() *Inflector {
+	return newInflector(portugueseTables)
+}
+
+func init() {
+	Register("pt", NewPortuguese())
+}
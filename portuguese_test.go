@@ -0,0 +1,90 @@
+package inflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var portugueseTests = [][]string{
+	// Regular.
+	{"casa", "casas"},
+	{"livro", "livros"},
+	{"café", "cafés"},
+	{"avó", "avós"},
+	{"rei", "reis"},
+	{"parte", "partes"},
+	{"padre", "padres"},
+	{"tigre", "tigres"},
+	{"febre", "febres"},
+	{"árvore", "árvores"},
+	// `r` and `z` add `es`.
+	{"flor", "flores"},
+	{"mulher", "mulheres"},
+	{"cor", "cores"},
+	{"luz", "luzes"},
+	{"rapaz", "rapazes"},
+	{"vez", "vezes"},
+	// `m` becomes `ns`.
+	{"homem", "homens"},
+	{"jardim", "jardins"},
+	{"som", "sons"},
+	{"atum", "atuns"},
+	// `ão` becomes `ões`, `ãos` or `ães`.
+	{"coração", "corações"},
+	{"nação", "nações"},
+	{"avião", "aviões"},
+	{"leão", "leões"},
+	{"mão", "mãos"},
+	{"irmão", "irmãos"},
+	{"cidadão", "cidadãos"},
+	{"órgão", "órgãos"},
+	{"pão", "pães"},
+	{"cão", "cães"},
+	{"alemão", "alemães"},
+	{"capitão", "capitães"},
+	// `l` becomes `is`.
+	{"animal", "animais"},
+	{"jornal", "jornais"},
+	{"papel", "papéis"},
+	{"hotel", "hotéis"},
+	{"anzol", "anzóis"},
+	{"farol", "faróis"},
+	{"azul", "azuis"},
+	{"nível", "níveis"},
+	{"móvel", "móveis"},
+	{"automóvel", "automóveis"},
+	{"fóssil", "fósseis"},
+	// `ês` becomes `eses`.
+	{"mês", "meses"},
+	{"inglês", "ingleses"},
+	{"português", "portugueses"},
+	{"hipótese", "hipóteses"},
+	{"tese", "teses"},
+	{"francês", "franceses"},
+	{"holandês", "holandeses"},
+	// Irregular.
+	{"país", "países"},
+	{"gás", "gases"},
+	{"deus", "deuses"},
+	// Invariant.
+	{"lápis", "lápis"},
+	{"ônibus", "ônibus"},
+	{"vírus", "vírus"},
+	{"tórax", "tórax"},
+	// Case.
+	{"Coração", "Corações"},
+	{"CORAÇÃO", "CORAÇÕES"},
+	{"Papel", "Papéis"},
+	{"PÃO", "PÃES"},
+}
+
+func TestPortuguese(t *testing.T) {
+	testLanguage(t, NewPortuguese(), portugueseTests)
+}
+
+func TestPortugueseRegistered(t *testing.T) {
+	assert.Equal(t, "corações", For("pt").ToPlural("coração"))
+	assert.Equal(t, "corações", For("pt-BR").ToPlural("coração"))
+	assert.Equal(t, "animal", For("pt_PT").ToSingular("animais"))
+}
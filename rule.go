@@ -0,0 +1,273 @@
+package inflect
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+)
+
+// RuleKind tells what a rule is used for.
+type RuleKind int
+
+const (
+	// PluralRule is a rule for pluralizing words.
+	PluralRule RuleKind = iota
+	// SingularRule is a rule for singularizing words.
+	SingularRule
+	// IrregularRule maps a singular word to its plural form and back.
+	IrregularRule
+	// UncountableRule is a word, or a regexp, with no separate plural form.
+	UncountableRule
+	// AcronymRule is an acronym like "URL", pluralized with a lower case "s".
+	AcronymRule
+)
+
+// RuleError describes why a rule couldn't be compiled.
+type RuleError struct {
+	Rule        string // the rule as given
+	Replacement string // the replacement as given, if relevant
+	Pos         int    // byte offset in Rule where the problem is, -1 if unknown
+	Reason      string
+	Err         error // underlying regexp error, if any
+}
+
+func (e *RuleError) Error() string {
+	if e.Pos >= 0 {
+		return fmt.Sprintf("inflect: invalid rule %q at position %d: %s", e.Rule, e.Pos, e.Reason)
+	}
+	return fmt.Sprintf("inflect: invalid rule %q: %s", e.Rule, e.Reason)
+}
+
+// Unwrap returns the underlying regexp error, if any.
+func (e *RuleError) Unwrap() error {
+	return e.Err
+}
+
+type rxRule struct {
+	// original and translated rule, reported by Explain
+	rxStrJs string
+	rxStrGo string
+
+	rx            *regexp.Regexp
+	replacement   string
+	replacementJs string
+
+	// nil if the rule is not a pure suffix pattern
+	suffix *suffixPattern
+}
+
+// CompileRule compiles a rule in the syntax accepted by AddPluralRule into
+// a Go regexp. It returns a *RuleError if the rule can't be translated
+// from JavaScript syntax, isn't a valid regexp or if the replacement refers
+// to groups that the rule doesn't have.
+func CompileRule(rule, replacement string) (*regexp.Regexp, error) {
+	r, err := newRxRule(rule, replacement)
+	if err != nil {
+		return nil, err
+	}
+	return r.rx, nil
+}
+
+func newRxRule(rule string, replacement string) (rxRule, error) {
+	rx, rxStrGo, err := sanitizeRule(rule)
+	if err != nil {
+		return rxRule{}, err
+	}
+	repl, maxGroup := jsReplaceSyntaxToGo(replacement)
+	if maxGroup > rx.NumSubexp() {
+		return rxRule{}, &RuleError{
+			Rule:        rule,
+			Replacement: replacement,
+			Pos:         -1,
+			Reason:      fmt.Sprintf("replacement %q refers to group $%d but the rule has %d groups", replacement, maxGroup, rx.NumSubexp()),
+		}
+	}
+	return rxRule{
+		rxStrJs:       rule,
+		rxStrGo:       rxStrGo,
+		rx:            rx,
+		replacement:   repl,
+		replacementJs: replacement,
+		suffix:        compileSuffixPattern(rxStrGo, repl),
+	}, nil
+}
+
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// JavaScript regexp constructs that Go doesn't support
+var jsUnsupported = []struct {
+	prefix string
+	reason string
+}{
+	{"(?=", "lookahead assertions are not supported"},
+	{"(?!", "negative lookahead assertions are not supported"},
+	{"(?<=", "lookbehind assertions are not supported"},
+	{"(?<!", "negative lookbehind assertions are not supported"},
+}
+
+// best-effort of converting javascript regex syntax to equivalent go syntax
+func jsRxSyntaxToGo(rx string) (string, error) {
+	if len(rx) == 0 || rx[0] != '/' {
+		return "", &RuleError{Rule: rx, Pos: 0, Reason: "expected regexp to start with '/'"}
+	}
+	end := strings.LastIndexByte(rx, '/')
+	nBackslash := 0
+	for i := end - 1; i > 0 && rx[i] == '\\'; i-- {
+		nBackslash++
+	}
+	if end == 0 || nBackslash%2 == 1 {
+		return "", &RuleError{Rule: rx, Pos: len(rx), Reason: "expected regexp to end with '/'"}
+	}
+	if end == 1 {
+		return "", &RuleError{Rule: rx, Pos: 1, Reason: "empty regexp"}
+	}
+	flags := ""
+	for i := end + 1; i < len(rx); i++ {
+		switch rx[i] {
+		case 'i', 'm', 's':
+			flags += rx[i : i+1]
+		case 'g', 'u':
+			// no Go equivalent and no effect when matching a single word
+		default:
+			return "", &RuleError{Rule: rx, Pos: i, Reason: fmt.Sprintf("unsupported flag %q", rx[i])}
+		}
+	}
+
+	var b strings.Builder
+	if flags != "" {
+		b.WriteString("(?" + flags + ")")
+	}
+	for i := 1; i < end; i++ {
+		c := rx[i]
+		if c == '(' {
+			for _, u := range jsUnsupported {
+				if strings.HasPrefix(rx[i:end], u.prefix) {
+					return "", &RuleError{Rule: rx, Pos: i, Reason: u.reason}
+				}
+			}
+		}
+		if c != '\\' {
+			b.WriteByte(c)
+			continue
+		}
+		if i+1 >= end {
+			return "", &RuleError{Rule: rx, Pos: i, Reason: "trailing backslash"}
+		}
+		next := rx[i+1]
+		switch {
+		case next == 'u':
+			// \uNNNN syntax for unicode code points to \x{NNNN} syntax for hex character code
+			if i+6 > end || !isHex(rx[i+2:i+6]) {
+				return "", &RuleError{Rule: rx, Pos: i, Reason: `expected 4 hex digits after \u`}
+			}
+			b.WriteString(`\x{` + rx[i+2:i+6] + `}`)
+			i += 5
+		case next >= '1' && next <= '9':
+			return "", &RuleError{Rule: rx, Pos: i, Reason: "backreferences are not supported"}
+		default:
+			b.WriteByte(c)
+			b.WriteByte(next)
+			i++
+		}
+	}
+	return b.String(), nil
+}
+
+// converts $N (and $& for the whole match) to ${N} syntax used by Go.
+// Also returns the highest group number used.
+func jsReplaceSyntaxToGo(s string) (string, int) {
+	if !strings.Contains(s, "$") {
+		return s, 0
+	}
+	var b strings.Builder
+	maxGroup := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '$' || i+1 >= len(s) {
+			b.WriteByte(c)
+			continue
+		}
+		next := s[i+1]
+		switch {
+		case next == '$':
+			b.WriteString("$$")
+			i++
+		case next == '&':
+			b.WriteString("${0}")
+			i++
+		case next >= '0' && next <= '9':
+			n := 0
+			j := i + 1
+			for ; j < len(s) && s[j] >= '0' && s[j] <= '9'; j++ {
+				n = n*10 + int(s[j]-'0')
+			}
+			fmt.Fprintf(&b, "${%d}", n)
+			if n > maxGroup {
+				maxGroup = n
+			}
+			i = j - 1
+		default:
+			// Go would treat it as a named group reference
+			b.WriteString("$$")
+		}
+	}
+	return b.String(), maxGroup
+}
+
+// Sanitize a pluralization rule to a usable regular expression.
+func sanitizeRule(rule string) (*regexp.Regexp, string, error) {
+	if rule == "" {
+		return nil, "", &RuleError{Rule: rule, Pos: -1, Reason: "empty rule"}
+	}
+	// in JavaScript, regexpes start with /
+	// others are just regular strings
+	var s string
+	if rule[0] != '/' {
+		// a plain string match is converted to regexp that:
+		// ^ ... $ : does exact match (matches at the beginning and end)
+		// (?i) : is case-insensitive
+		s = `(?i)^` + rule + `$`
+	} else {
+		var err error
+		s, err = jsRxSyntaxToGo(rule)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	rx, err := regexp.Compile(s)
+	if err != nil {
+		e := &RuleError{Rule: rule, Pos: -1, Reason: err.Error(), Err: err}
+		if se, ok := err.(*syntax.Error); ok {
+			expr := stripAddedSyntax(rule, se.Expr)
+			e.Reason = fmt.Sprintf("%s: `%s`", se.Code, expr)
+			e.Pos = strings.Index(rule, expr)
+		}
+		return nil, "", e
+	}
+	return rx, s, nil
+}
+
+// removes the flags and anchors that sanitizeRule added to the rule
+// from a part of the regexp reported in an error
+func stripAddedSyntax(rule string, expr string) string {
+	if strings.HasPrefix(expr, "(?") {
+		end := strings.IndexByte(expr, ')')
+		if end > 0 && strings.Trim(expr[2:end], "ims") == "" {
+			expr = expr[end+1:]
+		}
+	}
+	if rule[0] != '/' {
+		expr = strings.TrimPrefix(expr, "^")
+		expr = strings.TrimSuffix(expr, "$")
+	}
+	return expr
+}
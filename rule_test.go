@@ -0,0 +1,119 @@
+package inflect
+
+import (
+	"errors"
+	"regexp/syntax"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJsRxSyntaxToGo(t *testing.T) {
+	tests := [][]string{
+		{`/s?$/i`, `(?i)s?$`},
+		{`/[^\u0000-\u007F]$/i`, `(?i)[^\x{0000}-\x{007F}]$`},
+		{`/singles$/`, `singles$`},
+		{`/a\/b$/gi`, `(?i)a\/b$`},
+		{`/^foo$/m`, `(?m)^foo$`},
+	}
+	for _, test := range tests {
+		got, err := jsRxSyntaxToGo(test[0])
+		assert.NoError(t, err)
+		assert.Equal(t, test[1], got, "rx: %s", test[0])
+	}
+}
+
+func TestJsReplaceSyntaxToGo(t *testing.T) {
+	tests := []struct {
+		s        string
+		exp      string
+		maxGroup int
+	}{
+		{"", "", 0},
+		{"ies", "ies", 0},
+		{"$0", "${0}", 0},
+		{"$1$2ves", "${1}${2}ves", 2},
+		{"$12", "${12}", 12},
+		{"$&s", "${0}s", 0},
+		{"$$", "$$", 0},
+		{"$x", "$$x", 0},
+	}
+	for _, test := range tests {
+		got, maxGroup := jsReplaceSyntaxToGo(test.s)
+		assert.Equal(t, test.exp, got, "s: %s", test.s)
+		assert.Equal(t, test.maxGroup, maxGroup, "s: %s", test.s)
+	}
+}
+
+func TestCompileRuleErrors(t *testing.T) {
+	tests := []struct {
+		rule        string
+		replacement string
+		pos         int
+		reason      string
+	}{
+		{"", "", -1, "empty rule"},
+		{"/foo$", "", 5, "expected regexp to end with '/'"},
+		{"//i", "", 1, "empty regexp"},
+		{"/foo$/x", "", 6, `unsupported flag 'x'`},
+		{`/foo\u12$/`, "", 4, `expected 4 hex digits after \u`},
+		{`/(a)\1$/`, "", 4, "backreferences are not supported"},
+		{`/foo(?=bar)/`, "", 4, "lookahead assertions are not supported"},
+		{`/(?<!a)b$/i`, "", 1, "negative lookbehind assertions are not supported"},
+		{`/foo\/`, "", 6, "expected regexp to end with '/'"},
+		{`/(foo$/i`, "", 1, "missing closing ): `(foo$`"},
+		{`/[a-$/i`, "", 2, "invalid character class range: `a-$`"},
+		{"(foo", "", 0, "missing closing ): `(foo`"},
+		{`/(foo)$/i`, "$2", -1, `replacement "$2" refers to group $2 but the rule has 1 groups`},
+	}
+	for _, test := range tests {
+		rx, err := CompileRule(test.rule, test.replacement)
+		assert.Nil(t, rx)
+		var re *RuleError
+		if !assert.True(t, errors.As(err, &re), "rule: %s", test.rule) {
+			continue
+		}
+		assert.Equal(t, test.rule, re.Rule)
+		assert.Equal(t, test.pos, re.Pos, "rule: %s", test.rule)
+		assert.Equal(t, test.reason, re.Reason, "rule: %s", test.rule)
+	}
+
+	_, err := CompileRule(`/(foo$/i`, "")
+	var se *syntax.Error
+	assert.True(t, errors.As(err, &se))
+	assert.Equal(t, `inflect: invalid rule "/(foo$/i" at position 1: missing closing ): `+"`(foo$`", err.Error())
+	_, err = CompileRule(`/(a)\1$/`, "")
+	assert.Equal(t, `inflect: invalid rule "/(a)\\1$/" at position 4: backreferences are not supported`, err.Error())
+}
+
+func TestCompileRule(t *testing.T) {
+	rx, err := CompileRule(`/(matr)ix$/i`, "$1ices")
+	assert.NoError(t, err)
+	assert.Equal(t, "MATRICES", rx.ReplaceAllString("MATRIX", "${1}ICES"))
+
+	rx, err = CompileRule("person", "people")
+	assert.NoError(t, err)
+	assert.True(t, rx.MatchString("Person"))
+	assert.False(t, rx.MatchString("persons"))
+}
+
+func TestAddRule(t *testing.T) {
+	in := New()
+	assert.NoError(t, in.AddRule(PluralRule, `/gex$/i`, "gexii"))
+	assert.NoError(t, in.AddRule(SingularRule, `/gexii$/i`, "gex"))
+	assert.NoError(t, in.AddRule(IrregularRule, "schema", "schemas"))
+	assert.NoError(t, in.AddRule(UncountableRule, "sku", ""))
+	assert.Equal(t, "regexii", in.ToPlural("regex"))
+	assert.Equal(t, "regex", in.ToSingular("regexii"))
+	assert.Equal(t, "schemas", in.ToPlural("schema"))
+	assert.Equal(t, "sku", in.ToPlural("sku"))
+
+	rulesBefore := in.load()
+	assert.Error(t, in.AddRule(PluralRule, `/(foo$/i`, "bar"))
+	assert.Error(t, in.AddRule(UncountableRule, `/(foo$/i`, ""))
+	assert.Error(t, in.AddRule(UncountableRule, "", ""))
+	assert.Error(t, in.AddRule(IrregularRule, "foo", ""))
+	assert.Error(t, in.AddRule(RuleKind(42), "foo", "bar"))
+	// failed updates don't change the rules
+	assert.True(t, rulesBefore == in.load())
+}
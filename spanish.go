@@ -0,0 +1,120 @@
+package inflect
+
+var spanishIrregularRules = [][]string{
+	// Stress moves to another syllable.
+	{"joven", "jóvenes"},
+	{"examen", "exámenes"},
+	{"origen", "orígenes"},
+	{"imagen", "imágenes"},
+	{"volumen", "volúmenes"},
+	{"resumen", "resúmenes"},
+	{"crimen", "crímenes"},
+	{"margen", "márgenes"},
+	{"virgen", "vírgenes"},
+	{"germen", "gérmenes"},
+	{"orden", "órdenes"},
+	{"certamen", "certámenes"},
+	{"dictamen", "dictámenes"},
+	{"abdomen", "abdómenes"},
+	{"carácter", "caracteres"},
+	{"régimen", "regímenes"},
+	{"espécimen", "especímenes"},
+	// Singular words ending in `s`.
+	{"mes", "meses"},
+	{"gas", "gases"},
+	{"dios", "dioses"},
+	{"autobús", "autobuses"},
+	{"compás", "compases"},
+	{"adiós", "adioses"},
+	// Words ending in a stressed vowel.
+	{"café", "cafés"},
+	{"bebé", "bebés"},
+	{"comité", "comités"},
+	{"puré", "purés"},
+	{"rubí", "rubíes"},
+	{"hindú", "hindúes"},
+	// Other irregular rules.
+	{"cine", "cines"},
+	{"club", "clubes"},
+	{"álbum", "álbumes"},
+}
+
+var spanishPluralizationRules = [][]string{
+	{`/$/`, `s`},
+	{`/([^aeiouáéíóú])$/i`, `$1es`},
+	{`/z$/i`, `ces`},
+	// The accent is lost when a syllable is added, e.g. canción, canciones.
+	{`/án$/i`, `anes`},
+	{`/én$/i`, `enes`},
+	{`/ín$/i`, `ines`},
+	{`/ón$/i`, `ones`},
+	{`/ún$/i`, `unes`},
+	{`/és$/i`, `eses`},
+	// Already plural, e.g. sofás, menús.
+	{`/([áíóú]s)$/i`, `$1`},
+	// The accent stays when it splits vowels, e.g. país, países.
+	{`/([aeo][íú])([ns])$/i`, `$1$2es`},
+	// Words of more than one syllable ending in unstressed `s` or `x`.
+	{`/[aeiouáéíóú][^aeiouáéíóú]{1,3}[aeiou][sx]$/i`, `$0`},
+}
+
+var spanishSingularizationRules = [][]string{
+	{`/s$/i`, ``},
+	{`/([aeiouáéíóú][lrndjy])es$/i`, `$1`},
+	{`/([aeiouáéíóú])ces$/i`, `$1z`},
+	{`/([eiou]s)es$/i`, `$1`},
+	// The accent comes back, e.g. canciones, canción.
+	{`/([aeiou][^aeiouáéíóú]{1,3})anes$/i`, `$1án`},
+	{`/([aeiou][^aeiouáéíóú]{1,3})enes$/i`, `$1én`},
+	{`/([aeiou][^aeiouáéíóú]{1,3})ines$/i`, `$1ín`},
+	{`/([aeiou][^aeiouáéíóú]{1,3})ones$/i`, `$1ón`},
+	{`/([aeiou][^aeiouáéíóú]{1,3})unes$/i`, `$1ún`},
+	{`/([aeiou][^aeiouáéíóú]{1,3})eses$/i`, `$1és`},
+	{`/([aeiou][^aeiouáéíóú]{1,3})uses$/i`, `$1ús`},
+	{`/iones$/i`, `ión`},
+	{`/eones$/i`, `eón`},
+	{`/([aeo][íú][ns])es$/i`, `$1`},
+	{`/([íú])es$/i`, `$1`},
+	// Already singular.
+	{`/és$/i`, `$0`},
+	{`/[aeo][íú]s$/i`, `$0`},
+	{`/[iu]s$/i`, `$0`},
+}
+
+var spanishUncountableRules = []string{
+	// Days of the week.
+	"lunes",
+	"martes",
+	"miércoles",
+	"jueves",
+	"viernes",
+	// Compound words.
+	"abrelatas",
+	"cumpleaños",
+	"lavaplatos",
+	"paraguas",
+	"sacacorchos",
+	"tocadiscos",
+	// Other uncountable words.
+	"atlas",
+	"déficit",
+	"gafas",
+	"superávit",
+	"tijeras",
+}
+
+var spanishTables = &ruleTables{
+	irregular:   spanishIrregularRules,
+	plural:      spanishPluralizationRules,
+	singular:    spanishSingularizationRules,
+	uncountable: spanishUncountableRules,
+}
+
+// NewSpanish returns an Inflector initialized with Spanish rules.
+func NewSpanish() *Inflector {
+	return newInflector(spanishTables)
+}
+
+func init() {
+	Register("es", NewSpanish())
+}
@@ -0,0 +1,101 @@
+package inflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var spanishTests = [][]string{
+	// Vowels.
+	{"casa", "casas"},
+	{"libro", "libros"},
+	{"noche", "noches"},
+	{"calle", "calles"},
+	{"madre", "madres"},
+	{"clase", "clases"},
+	{"sofá", "sofás"},
+	{"menú", "menús"},
+	{"esquí", "esquís"},
+	// Consonants.
+	{"árbol", "árboles"},
+	{"papel", "papeles"},
+	{"flor", "flores"},
+	{"mujer", "mujeres"},
+	{"color", "colores"},
+	{"ciudad", "ciudades"},
+	{"reloj", "relojes"},
+	{"rey", "reyes"},
+	{"ley", "leyes"},
+	{"tren", "trenes"},
+	{"pan", "panes"},
+	{"bien", "bienes"},
+	{"baúl", "baúles"},
+	{"ataúd", "ataúdes"},
+	// `z` becomes `c`.
+	{"lápiz", "lápices"},
+	{"luz", "luces"},
+	{"vez", "veces"},
+	{"pez", "peces"},
+	{"raíz", "raíces"},
+	{"maíz", "maíces"},
+	// Accents.
+	{"canción", "canciones"},
+	{"camión", "camiones"},
+	{"león", "leones"},
+	{"alemán", "alemanes"},
+	{"capitán", "capitanes"},
+	{"jardín", "jardines"},
+	{"atún", "atunes"},
+	{"inglés", "ingleses"},
+	{"interés", "intereses"},
+	{"país", "países"},
+	// Irregular.
+	{"joven", "jóvenes"},
+	{"examen", "exámenes"},
+	{"imagen", "imágenes"},
+	{"orden", "órdenes"},
+	{"carácter", "caracteres"},
+	{"régimen", "regímenes"},
+	{"mes", "meses"},
+	{"dios", "dioses"},
+	{"autobús", "autobuses"},
+	{"café", "cafés"},
+	{"rubí", "rubíes"},
+	{"cine", "cines"},
+	// Invariant.
+	{"crisis", "crisis"},
+	{"análisis", "análisis"},
+	{"tesis", "tesis"},
+	{"virus", "virus"},
+	{"tórax", "tórax"},
+	{"lunes", "lunes"},
+	{"viernes", "viernes"},
+	{"cumpleaños", "cumpleaños"},
+	{"paraguas", "paraguas"},
+	// Case.
+	{"Canción", "Canciones"},
+	{"CANCIÓN", "CANCIONES"},
+	{"LÁPIZ", "LÁPICES"},
+	{"Joven", "Jóvenes"},
+}
+
+func TestSpanish(t *testing.T) {
+	testLanguage(t, NewSpanish(), spanishTests)
+}
+
+func TestSpanishRegistered(t *testing.T) {
+	assert.Equal(t, "canciones", For("es").ToPlural("canción"))
+	assert.Equal(t, "canciones", For("es-MX").ToPlural("canción"))
+	assert.Equal(t, "lápiz", For("es_419").ToSingular("lápices"))
+	assert.Equal(t, "people", For("en").ToPlural("person"))
+}
+
+func TestSpanishIsSeparate(t *testing.T) {
+	es := NewSpanish()
+	es.AddUncountableRule("menú")
+	assert.Equal(t, "menú", es.ToPlural("menú"))
+	assert.Equal(t, "menús", NewSpanish().ToPlural("menú"))
+	// the default Inflector is English
+	assert.Equal(t, "cancións", ToPlural("canción"))
+}
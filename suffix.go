@@ -0,0 +1,473 @@
+package inflect
+
+import (
+	"regexp/syntax"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Nearly all rules are regexps anchored at the end of the word, like
+// `(x|ch|ss|sh|zz)$`. Such a rule matches a finite set of suffixes, so
+// instead of running the regexps one by one, the suffixes of all rules are
+// merged into a trie of character classes which is walked backwards from
+// the end of the word. A single scan finds all matching rules and picks
+// the one with the highest priority.
+// Rules that can't be expressed as a set of suffixes are matched with
+// regexps, as before.
+
+const (
+	maxSuffixLen  = 32  // longest suffix, in runes, stored in the trie
+	maxSuffixAlts = 512 // most suffixes a single rule can expand to
+)
+
+type anchorKind uint8
+
+const (
+	anchorNone         anchorKind = iota
+	anchorBegin                   // ^ before the suffix, it must be the whole word
+	anchorWordBoundary            // \b before the suffix
+)
+
+// suffixAlt is one of the suffixes matched by a rule.
+type suffixAlt struct {
+	classes [][]rune // character classes, from the start of the suffix
+	anchor  anchorKind
+	// groups[n] is the span of capture group n, given as the number of
+	// runes from the end of the word to its start and to its end.
+	// It's {-1, -1} if the group doesn't participate in the match.
+	groups [][2]int
+}
+
+// tmplPiece is a part of a replacement: a literal or a group reference.
+type tmplPiece struct {
+	lit      string
+	litUpper string
+	group    int // -1 for literals
+}
+
+// suffixPattern is a rule compiled to a list of suffixes it matches,
+// in the order of regexp priority.
+type suffixPattern struct {
+	alts []suffixAlt
+	tmpl []tmplPiece
+}
+
+// compileSuffixPattern returns nil if rx is not a pure suffix pattern
+// or if the replacement uses syntax we don't handle.
+func compileSuffixPattern(rx string, replacement string) *suffixPattern {
+	re, err := syntax.Parse(rx, syntax.Perl)
+	if err != nil {
+		return nil
+	}
+	re = re.Simplify()
+	items, ok := expandRegexp(re)
+	if !ok {
+		return nil
+	}
+	tmpl, ok := parseTemplate(replacement)
+	if !ok {
+		return nil
+	}
+	res := &suffixPattern{tmpl: tmpl}
+	for _, it := range items {
+		alt, ok := newSuffixAlt(it, re.MaxCap())
+		if !ok {
+			return nil
+		}
+		res.alts = append(res.alts, alt)
+	}
+	return res
+}
+
+type itemKind uint8
+
+const (
+	itemClass itemKind = iota
+	itemOpen
+	itemClose
+	itemBegin
+	itemWordBoundary
+	itemEnd
+)
+
+type item struct {
+	kind  itemKind
+	class []rune
+	group int
+}
+
+// returns sorted ranges of runes equal to r under simple case folding
+func foldClass(r rune) []rune {
+	runes := []rune{r}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		runes = append(runes, f)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	var res []rune
+	for _, c := range runes {
+		n := len(res)
+		if n > 0 && res[n-1]+1 == c {
+			res[n-1] = c
+			continue
+		}
+		res = append(res, c, c)
+	}
+	return res
+}
+
+// expandRegexp lists all sequences of items matched by re, in the order
+// of priority used by the regexp engine. It returns false if there are
+// too many or infinitely many of them.
+func expandRegexp(re *syntax.Regexp) ([][]item, bool) {
+	one := func(it item) ([][]item, bool) {
+		return [][]item{{it}}, true
+	}
+	switch re.Op {
+	case syntax.OpEmptyMatch:
+		return [][]item{nil}, true
+	case syntax.OpLiteral:
+		alt := make([]item, len(re.Rune))
+		for i, r := range re.Rune {
+			class := []rune{r, r}
+			if re.Flags&syntax.FoldCase != 0 {
+				class = foldClass(r)
+			}
+			alt[i] = item{kind: itemClass, class: class}
+		}
+		return [][]item{alt}, true
+	case syntax.OpCharClass:
+		return one(item{kind: itemClass, class: re.Rune})
+	case syntax.OpAnyCharNotNL:
+		return one(item{kind: itemClass, class: []rune{0, '\n' - 1, '\n' + 1, unicode.MaxRune}})
+	case syntax.OpAnyChar:
+		return one(item{kind: itemClass, class: []rune{0, unicode.MaxRune}})
+	case syntax.OpBeginText:
+		return one(item{kind: itemBegin})
+	case syntax.OpEndText:
+		return one(item{kind: itemEnd})
+	case syntax.OpWordBoundary:
+		return one(item{kind: itemWordBoundary})
+	case syntax.OpCapture:
+		subs, ok := expandRegexp(re.Sub[0])
+		if !ok {
+			return nil, false
+		}
+		res := make([][]item, len(subs))
+		for i, sub := range subs {
+			alt := []item{{kind: itemOpen, group: re.Cap}}
+			alt = append(alt, sub...)
+			res[i] = append(alt, item{kind: itemClose, group: re.Cap})
+		}
+		return res, true
+	case syntax.OpConcat:
+		res := [][]item{nil}
+		for _, sub := range re.Sub {
+			subs, ok := expandRegexp(sub)
+			if !ok || len(res)*len(subs) > maxSuffixAlts {
+				return nil, false
+			}
+			var next [][]item
+			for _, a := range res {
+				for _, b := range subs {
+					alt := append([]item(nil), a...)
+					next = append(next, append(alt, b...))
+				}
+			}
+			res = next
+		}
+		return res, true
+	case syntax.OpAlternate:
+		var res [][]item
+		for _, sub := range re.Sub {
+			subs, ok := expandRegexp(sub)
+			if !ok || len(res)+len(subs) > maxSuffixAlts {
+				return nil, false
+			}
+			res = append(res, subs...)
+		}
+		return res, true
+	case syntax.OpQuest:
+		subs, ok := expandRegexp(re.Sub[0])
+		if !ok || len(subs)+1 > maxSuffixAlts {
+			return nil, false
+		}
+		if re.Flags&syntax.NonGreedy != 0 {
+			return append([][]item{nil}, subs...), true
+		}
+		return append(subs, nil), true
+	}
+	return nil, false
+}
+
+// newSuffixAlt returns false unless items are an optional anchor,
+// character classes and the end of text.
+func newSuffixAlt(items []item, maxCap int) (suffixAlt, bool) {
+	var alt suffixAlt
+	starts := make([]int, maxCap+1)
+	ends := make([]int, maxCap+1)
+	for i := range starts {
+		starts[i] = -1
+	}
+	ended := false
+	for _, it := range items {
+		switch it.kind {
+		case itemClass:
+			if ended {
+				return alt, false
+			}
+			alt.classes = append(alt.classes, it.class)
+		case itemOpen:
+			starts[it.group] = len(alt.classes)
+		case itemClose:
+			ends[it.group] = len(alt.classes)
+		case itemBegin, itemWordBoundary:
+			if ended || alt.anchor != anchorNone || len(alt.classes) > 0 {
+				return alt, false
+			}
+			alt.anchor = anchorBegin
+			if it.kind == itemWordBoundary {
+				alt.anchor = anchorWordBoundary
+			}
+		case itemEnd:
+			ended = true
+		}
+	}
+	n := len(alt.classes)
+	if !ended || n > maxSuffixLen {
+		return alt, false
+	}
+	alt.groups = make([][2]int, maxCap+1)
+	alt.groups[0] = [2]int{n, 0}
+	for g := 1; g <= maxCap; g++ {
+		alt.groups[g] = [2]int{-1, -1}
+		if starts[g] >= 0 {
+			alt.groups[g] = [2]int{n - starts[g], n - ends[g]}
+		}
+	}
+	return alt, true
+}
+
+// parseTemplate handles replacements in the form created by
+// jsReplaceSyntaxToGo i.e. literals, ${N} and $$
+func parseTemplate(tmpl string) ([]tmplPiece, bool) {
+	var res []tmplPiece
+	var lit []byte
+	flush := func() {
+		if len(lit) > 0 {
+			s := string(lit)
+			res = append(res, tmplPiece{lit: s, litUpper: strings.ToUpper(s), group: -1})
+			lit = lit[:0]
+		}
+	}
+	for i := 0; i < len(tmpl); i++ {
+		c := tmpl[i]
+		if c != '$' {
+			lit = append(lit, c)
+			continue
+		}
+		if i+1 < len(tmpl) && tmpl[i+1] == '$' {
+			lit = append(lit, '$')
+			i++
+			continue
+		}
+		if i+1 >= len(tmpl) || tmpl[i+1] != '{' {
+			return nil, false
+		}
+		n := 0
+		j := i + 2
+		for ; j < len(tmpl) && tmpl[j] >= '0' && tmpl[j] <= '9'; j++ {
+			n = n*10 + int(tmpl[j]-'0')
+			if n > maxSuffixAlts {
+				return nil, false
+			}
+		}
+		// no digits, leading zeros or no closing brace
+		if j == i+2 || (tmpl[i+2] == '0' && j > i+3) || j >= len(tmpl) || tmpl[j] != '}' {
+			return nil, false
+		}
+		flush()
+		res = append(res, tmplPiece{group: n})
+		i = j
+	}
+	flush()
+	return res, true
+}
+
+type suffixEdge struct {
+	class []rune // sorted pairs of inclusive rune ranges
+	next  *suffixNode
+}
+
+type suffixAccept struct {
+	rule   int // index of the rule
+	alt    int // index of the suffix in the rule's suffixes
+	anchor anchorKind
+	groups [][2]int
+	tmpl   []tmplPiece
+}
+
+type suffixNode struct {
+	edges   []suffixEdge
+	accepts []suffixAccept
+}
+
+// suffixMatcher finds the rule to apply to a word in a single backward
+// scan over the word.
+type suffixMatcher struct {
+	root suffixNode
+	// indexes of the rules that are not suffix patterns, in ascending order
+	fallback []int
+}
+
+func classEqual(a, b []rune) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func inClass(class []rune, r rune) bool {
+	for i := 0; i < len(class); i += 2 {
+		if r < class[i] {
+			return false
+		}
+		if r <= class[i+1] {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *suffixNode) child(class []rune) *suffixNode {
+	for _, e := range n.edges {
+		if classEqual(e.class, class) {
+			return e.next
+		}
+	}
+	next := &suffixNode{}
+	n.edges = append(n.edges, suffixEdge{class: class, next: next})
+	return next
+}
+
+func newSuffixMatcher(rules []rxRule) *suffixMatcher {
+	m := &suffixMatcher{}
+	for i, r := range rules {
+		if r.suffix == nil {
+			m.fallback = append(m.fallback, i)
+			continue
+		}
+		for j, alt := range r.suffix.alts {
+			node := &m.root
+			for k := len(alt.classes) - 1; k >= 0; k-- {
+				node = node.child(alt.classes[k])
+			}
+			node.accepts = append(node.accepts, suffixAccept{
+				rule:   i,
+				alt:    j,
+				anchor: alt.anchor,
+				groups: alt.groups,
+				tmpl:   r.suffix.tmpl,
+			})
+		}
+	}
+	return m
+}
+
+// suffixMatch is the result of suffixMatcher.find
+type suffixMatch struct {
+	acc   *suffixAccept
+	depth int // length of the match in runes
+	// offs[n] is the byte offset of n-th rune from the end of the word
+	offs [maxSuffixLen + 1]int
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// pick the rule that regexps would pick: the one added last and,
+// within a rule, the leftmost (i.e. longest) match
+func (res *suffixMatch) consider(word string, n *suffixNode, depth int) {
+	pos := res.offs[depth]
+	for i := range n.accepts {
+		acc := &n.accepts[i]
+		switch acc.anchor {
+		case anchorBegin:
+			if pos != 0 {
+				continue
+			}
+		case anchorWordBoundary:
+			before := pos > 0 && isWordByte(word[pos-1])
+			after := pos < len(word) && isWordByte(word[pos])
+			if before == after {
+				continue
+			}
+		}
+		best := res.acc
+		if best == nil || acc.rule > best.rule ||
+			(acc.rule == best.rule && (depth > res.depth || (depth == res.depth && acc.alt < best.alt))) {
+			res.acc = acc
+			res.depth = depth
+		}
+	}
+}
+
+// find returns false if no suffix pattern matches the word.
+// The word must be valid utf-8.
+func (m *suffixMatcher) find(word string, res *suffixMatch) bool {
+	var bufA, bufB [16]*suffixNode
+	active := append(bufA[:0], &m.root)
+	next := bufB[:0]
+	pos := len(word)
+	res.acc = nil
+	res.offs[0] = pos
+	res.consider(word, &m.root, 0)
+	for depth := 1; depth <= maxSuffixLen && pos > 0 && len(active) > 0; depth++ {
+		r, size := utf8.DecodeLastRuneInString(word[:pos])
+		pos -= size
+		res.offs[depth] = pos
+		next = next[:0]
+		for _, n := range active {
+			for i := range n.edges {
+				e := &n.edges[i]
+				if inClass(e.class, r) {
+					next = append(next, e.next)
+					res.consider(word, e.next, depth)
+				}
+			}
+		}
+		active, next = next, active
+	}
+	return res.acc != nil
+}
+
+// appendReplacement appends word with the matched suffix replaced.
+// If upper is true, literal parts of the replacement are upper-cased.
+func (res *suffixMatch) appendReplacement(dst []byte, word string, upper bool) []byte {
+	acc := res.acc
+	dst = append(dst, word[:res.offs[res.depth]]...)
+	for _, p := range acc.tmpl {
+		if p.group < 0 {
+			if upper {
+				dst = append(dst, p.litUpper...)
+			} else {
+				dst = append(dst, p.lit...)
+			}
+			continue
+		}
+		if p.group < len(acc.groups) {
+			g := acc.groups[p.group]
+			if g[0] >= 0 {
+				dst = append(dst, word[res.offs[g[0]]:res.offs[g[1]]]...)
+			}
+		}
+	}
+	return dst
+}
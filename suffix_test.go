@@ -0,0 +1,158 @@
+package inflect
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// words to compare matching with the trie and with regexps
+func suffixTestWords() []string {
+	var words []string
+	for _, test := range append(allPluralTests, singularTests...) {
+		for _, s := range test {
+			words = append(words, s, strings.ToUpper(s))
+			if len(s) > 0 {
+				words = append(words, strings.ToUpper(s[:1])+s[1:])
+			}
+		}
+	}
+	for _, tests := range [][][]string{spanishTests, frenchTests, portugueseTests, germanTests} {
+		for _, test := range tests {
+			words = append(words, test...)
+		}
+	}
+	words = append(words, "", "a", "s", "ies", "lives", "olives", "mice", "titmice", "a-lives",
+		"_lives", "x\xffs", "ÉCOLE", "café", "straße", "naïve", "thou", "THOU", "athou")
+	rnd := rand.New(rand.NewSource(1))
+	letters := "aeiouyxschmnlrtfvzgpbdAESXÉéíóúÍÓÚäöüß-_ "
+	for i := 0; i < 5000; i++ {
+		n := 1 + rnd.Intn(8)
+		var b strings.Builder
+		for j := 0; j < n; j++ {
+			b.WriteByte(letters[rnd.Intn(len(letters))])
+		}
+		words = append(words, b.String())
+	}
+	return words
+}
+
+func TestSuffixMatcherMatchesRegexp(t *testing.T) {
+	inflectors := []*Inflector{defaultInflector, NewSpanish(), NewFrench(), NewPortuguese()}
+	de := NewGerman()
+	for g := UnknownGender; g <= Neuter; g++ {
+		inflectors = append(inflectors, de.Gender(g))
+	}
+	for _, in := range inflectors {
+		rs := in.load()
+		testSuffixMatcher(t, rs, &rs.plural)
+		testSuffixMatcher(t, rs, &rs.singular)
+	}
+}
+
+func testSuffixMatcher(t *testing.T, rs *ruleSet, list *ruleList) {
+	assert.Empty(t, list.matcher.fallback, "all built-in rules should be suffix patterns")
+	rxOnly := &ruleList{rules: list.rules}
+	for _, word := range suffixTestWords() {
+		token := strings.ToLower(word)
+		var ex1, ex2 Inflection
+		got := rs.sanitizeWord(token, word, list, &ex1)
+		exp := rs.sanitizeWord(token, word, rxOnly, &ex2)
+		assert.Equal(t, exp, got, "word: %q", word)
+		assert.Equal(t, ex2.RuleIndex, ex1.RuleIndex, "word: %q", word)
+	}
+}
+
+func TestSuffixMatcherFallback(t *testing.T) {
+	in := New()
+	// not a suffix pattern, takes precedence over earlier rules
+	in.AddPluralRule(`/^(re+)gex$/i`, "$1gexen")
+	in.AddPluralRule(`/zz$/i`, "zzies")
+	rs := in.load()
+	assert.Equal(t, []int{len(rs.plural.rules) - 2}, rs.plural.matcher.fallback)
+	assert.Equal(t, "reeegexen", in.ToPlural("reeegex"))
+	assert.Equal(t, "regexen", in.ToPlural("regex"))
+	assert.Equal(t, "buzzies", in.ToPlural("buzz"))
+	// later suffix rule wins over earlier regexp rule
+	in.AddPluralRule(`/gex$/i`, "gexii")
+	assert.Equal(t, "regexii", in.ToPlural("regex"))
+}
+
+func TestCompileSuffixPattern(t *testing.T) {
+	supported := []string{
+		`(?i)s?$`,
+		`(?i)^thou$`,
+		`(?i)\b((?:tit)?m|l)(?:ice|ouse)$`,
+		`(?i)(wi|kni|(?:after|half|high|low|mid|non|night|[^\w]|^)li)ves$`,
+		`(?i)[^\x{0000}-\x{007F}]$`,
+		`a.b$`,
+	}
+	for _, rx := range supported {
+		assert.NotNil(t, compileSuffixPattern(rx, "${1}"), "rx: %s", rx)
+	}
+	unsupported := []string{
+		`(?i)s+$`,
+		`(?i)s*$`,
+		`(?i)gex`,
+		`(?i)(a$|b)`,
+		`(?m)ab$`,
+		`(?i)a\Bb$`,
+		`(?i)a^b$`,
+		`(?i)[a-z]{40}$`,
+	}
+	for _, rx := range unsupported {
+		assert.Nil(t, compileSuffixPattern(rx, ""), "rx: %s", rx)
+	}
+	assert.Nil(t, compileSuffixPattern(`(a)$`, "$name"))
+	assert.Nil(t, compileSuffixPattern(`(a)$`, "${01}"))
+	assert.NotNil(t, compileSuffixPattern(`(a)$`, "$$${1}"))
+}
+
+var benchWords = []string{
+	"user", "account", "address", "category", "person", "status", "index",
+	"matrix", "child", "mouse", "knife", "analysis", "datum", "octopus",
+	"box", "church", "quiz", "wolf", "hero", "sheep",
+}
+
+func benchmarkInflect(b *testing.B, rxOnly bool, plural bool) {
+	rs := defaultInflector.load()
+	replaceMap, keepMap, list := rs.irregularSingles, rs.irregularPlurals, &rs.plural
+	if !plural {
+		replaceMap, keepMap, list = rs.irregularPlurals, rs.irregularSingles, &rs.singular
+	}
+	if rxOnly {
+		list = &ruleList{rules: list.rules}
+	}
+	words := benchWords
+	if !plural {
+		words = nil
+		for _, w := range benchWords {
+			words = append(words, ToPlural(w))
+		}
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, w := range words {
+			rs.replaceWord(w, replaceMap, keepMap, list, nil)
+		}
+	}
+}
+
+func BenchmarkToPluralSuffix(b *testing.B) {
+	benchmarkInflect(b, false, true)
+}
+
+func BenchmarkToPluralRegexp(b *testing.B) {
+	benchmarkInflect(b, true, true)
+}
+
+func BenchmarkToSingularSuffix(b *testing.B) {
+	benchmarkInflect(b, false, false)
+}
+
+func BenchmarkToSingularRegexp(b *testing.B) {
+	benchmarkInflect(b, true, false)
+}